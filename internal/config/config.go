@@ -14,17 +14,89 @@ type Distribution struct {
 	Parenthesis int `json:"parenthesis"`
 	Colon       int `json:"colon"`
 	Semicolon   int `json:"semicolon"`
+	Apostrophe  int `json:"apostrophe"`
+	Dash        int `json:"dash"`
+	Hyphen      int `json:"hyphen"`
+	Ellipsis    int `json:"ellipsis"`
 }
 
+// NumberFormats weights the formats of numbers, the Distribution's Number weight the numbers in total.
+type NumberFormats struct {
+	Integer  int `json:"integer"`
+	Decimal  int `json:"decimal"`
+	Percent  int `json:"percent"`
+	Date     int `json:"date"`
+	Time     int `json:"time"`
+	Currency int `json:"currency"`
+	Phone    int `json:"phone"`
+	Version  int `json:"version"`
+	Range    int `json:"range"`
+}
+
+// SymbolDistribution weights the programming syntax of the symbol mode, Word leaves a word plain.
+type SymbolDistribution struct {
+	Word       int `json:"word"`
+	SnakeCase  int `json:"snakeCase"`
+	CamelCase  int `json:"camelCase"`
+	Call       int `json:"call"`
+	Arrow      int `json:"arrow"`
+	NotEqual   int `json:"notEqual"`
+	LogicalAnd int `json:"and"`
+	LogicalOr  int `json:"or"`
+	Include    int `json:"include"`
+	Variable   int `json:"variable"`
+	Tag        int `json:"tag"`
+	Index      int `json:"index"`
+	Assign     int `json:"assign"`
+}
+
+// Style of a cell, colors are names, 256 color indices or #rrggbb values.
+type Style struct {
+	Fg    string   `json:"fg,omitempty"`
+	Bg    string   `json:"bg,omitempty"`
+	Attrs []string `json:"attrs,omitempty"`
+}
+
+// Theme maps cell status names (queued, failed, passed, active, missed) to styles.
+type Theme map[string]Style
+
 type Config struct {
-	Version      int          `json:"version"`
-	Dictionary   string       `json:"dict"`
-	StrictMode   bool         `json:"strict"`
-	TopWords     int          `json:"top"`
-	WordCount    int          `json:"count"`
-	Width        int          `json:"width"`
-	Numbers      bool         `json:"nums"`
-	Punctuation  bool         `json:"punct"`
-	NoRepeat     int          `json:"noRepeat"`
-	Distribution Distribution `json:"freqs"`
+	Version      int                `json:"version"`
+	Dictionary   string             `json:"dict"`
+	Language     string             `json:"lang"` // of the punctuation rules, the dictionary's if empty
+	Source       string             `json:"source"`
+	Generator    string             `json:"gen"`
+	Corpus       string             `json:"corpus"`  // text file to train the Markov generator, bundled if empty
+	Charset      string             `json:"charset"` // letters of the pseudo-words, all if empty
+	StrictMode   bool               `json:"strict"`
+	WordMode     bool               `json:"wordMode"`
+	StopOnError  string             `json:"stopOnError"`
+	Correction   string             `json:"correction"`
+	Confidence   bool               `json:"confidence"`
+	AutoPause    bool               `json:"autoPause"`
+	IdleSeconds  int                `json:"idle"`
+	Rounds       int                `json:"rounds"`
+	WarmUp       bool               `json:"warmUp"`
+	History      bool               `json:"history"`
+	TopWords     int                `json:"top"`
+	WordCount    int                `json:"count"`
+	Width        int                `json:"width"`
+	Lines        int                `json:"lines"`
+	Numbers      bool               `json:"nums"`
+	Punctuation  bool               `json:"punct"`
+	Sentences    bool               `json:"sentences"` // structures the punctuation as sentences
+	Symbols      bool               `json:"syms"`      // programming syntax instead of punctuation
+	Case         string             `json:"case"`
+	CaseWeight   int                `json:"caseWeight"` // percentage of capitalized words in random case
+	NoRepeat     int                `json:"noRepeat"`
+	Seed         int64              `json:"seed"` // generates the same text for every test, random if zero
+	Distribution Distribution       `json:"freqs"`
+	NumberFormat NumberFormats      `json:"numberFormats"`
+	SymbolFreqs  SymbolDistribution `json:"symFreqs"`
+	Theme        string             `json:"theme"`
+	Themes       map[string]Theme   `json:"themes,omitempty"`
+	Caret        string             `json:"caret"`
+	CaretBlink   bool               `json:"caretBlink"`
+	Errors       string             `json:"errors"`
+	Extra        bool               `json:"extra"`
 }
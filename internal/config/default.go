@@ -2,15 +2,35 @@ package config
 
 func Default() Config {
 	return Config{
-		Version:     2,
+		Version:     23,
 		Dictionary:  "english",
+		Language:    "",
+		Source:      "words",
+		Generator:   "weighted",
+		Corpus:      "",
+		Charset:     "",
 		StrictMode:  false,
+		WordMode:    false,
+		StopOnError: "off",
+		Correction:  "free",
+		Confidence:  false,
+		AutoPause:   true,
+		IdleSeconds: 10,
+		Rounds:      1,
+		WarmUp:      false,
+		History:     false,
 		TopWords:    100,
 		WordCount:   20,
 		Width:       50,
+		Lines:       3,
 		Numbers:     false,
 		Punctuation: true,
+		Sentences:   false,
+		Symbols:     false,
+		Case:        "preserve",
+		CaseWeight:  20,
 		NoRepeat:    5,
+		Seed:        0,
 		Distribution: Distribution{
 			Word:        85,
 			Number:      7,
@@ -24,6 +44,42 @@ func Default() Config {
 			Parenthesis: 3,
 			Colon:       3,
 			Semicolon:   2,
+			Apostrophe:  3,
+			Dash:        2,
+			Hyphen:      2,
+			Ellipsis:    1,
 		},
+		NumberFormat: NumberFormats{
+			Integer:  10,
+			Decimal:  3,
+			Percent:  2,
+			Date:     2,
+			Time:     2,
+			Currency: 2,
+			Phone:    1,
+			Version:  1,
+			Range:    1,
+		},
+		SymbolFreqs: SymbolDistribution{
+			Word:       60,
+			SnakeCase:  6,
+			CamelCase:  6,
+			Call:       6,
+			Arrow:      3,
+			NotEqual:   3,
+			LogicalAnd: 3,
+			LogicalOr:  2,
+			Include:    1,
+			Variable:   4,
+			Tag:        3,
+			Index:      3,
+			Assign:     4,
+		},
+		Theme:      "default",
+		Themes:     nil,
+		Caret:      "reverse",
+		CaretBlink: false,
+		Errors:     "expected",
+		Extra:      false,
 	}
 }
@@ -10,6 +10,77 @@ func Migrations() []Migration {
 		func(cfg *Config) {
 			cfg.NoRepeat = Default().NoRepeat
 		},
+		func(cfg *Config) {
+			cfg.Theme = Default().Theme
+		},
+		func(cfg *Config) {
+			cfg.Caret = Default().Caret
+		},
+		func(cfg *Config) {
+			cfg.Lines = Default().Lines
+		},
+		func(cfg *Config) {
+			cfg.Errors = Default().Errors
+		},
+		func(cfg *Config) {
+			cfg.WordMode = Default().WordMode
+		},
+		func(cfg *Config) {
+			cfg.StopOnError = Default().StopOnError
+		},
+		func(cfg *Config) {
+			cfg.Correction = Default().Correction
+		},
+		func(cfg *Config) {
+			cfg.Confidence = Default().Confidence
+		},
+		func(cfg *Config) {
+			cfg.AutoPause = Default().AutoPause
+		},
+		func(cfg *Config) {
+			cfg.IdleSeconds = Default().IdleSeconds
+		},
+		func(cfg *Config) {
+			cfg.Rounds = Default().Rounds
+			cfg.WarmUp = Default().WarmUp
+			cfg.History = Default().History
+		},
+		func(cfg *Config) {
+			cfg.Seed = Default().Seed
+		},
+		func(cfg *Config) {
+			cfg.Distribution.Apostrophe = Default().Distribution.Apostrophe
+			cfg.Distribution.Dash = Default().Distribution.Dash
+			cfg.Distribution.Hyphen = Default().Distribution.Hyphen
+			cfg.Distribution.Ellipsis = Default().Distribution.Ellipsis
+		},
+		func(cfg *Config) {
+			cfg.Language = Default().Language
+		},
+		func(cfg *Config) {
+			cfg.Sentences = Default().Sentences
+		},
+		func(cfg *Config) {
+			cfg.NumberFormat = Default().NumberFormat
+		},
+		func(cfg *Config) {
+			cfg.Symbols = Default().Symbols
+			cfg.SymbolFreqs = Default().SymbolFreqs
+		},
+		func(cfg *Config) {
+			cfg.Generator = Default().Generator
+			cfg.Corpus = Default().Corpus
+		},
+		func(cfg *Config) {
+			cfg.Charset = Default().Charset
+		},
+		func(cfg *Config) {
+			cfg.Source = Default().Source
+		},
+		func(cfg *Config) {
+			cfg.Case = Default().Case
+			cfg.CaseWeight = Default().CaseWeight
+		},
 	}
 }
 
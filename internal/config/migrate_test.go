@@ -9,14 +9,33 @@ import (
 )
 
 const lastWorkingConfigExample = `{
-  "version": 1,
+  "version": 22,
   "dict": "german",
+  "lang": "",
+  "source": "words",
+  "gen": "weighted",
+  "corpus": "",
+  "charset": "",
   "strict": false,
+  "wordMode": false,
+  "stopOnError": "off",
+  "correction": "free",
+  "confidence": false,
+  "autoPause": true,
+  "idle": 10,
+  "rounds": 1,
+  "warmUp": false,
+  "history": true,
   "top": 100,
   "count": 20,
   "width": 30,
+  "lines": 3,
   "nums": true,
   "punct": true,
+  "sentences": false,
+  "syms": false,
+  "noRepeat": 5,
+  "seed": 0,
   "freqs": {
     "word": 85,
     "number": 7,
@@ -29,20 +48,75 @@ const lastWorkingConfigExample = `{
     "braces": 2,
     "parenthesis": 3,
     "colon": 3,
-    "semicolon": 2
-  }
+    "semicolon": 2,
+    "apostrophe": 3,
+    "dash": 2,
+    "hyphen": 2,
+    "ellipsis": 1
+  },
+  "numberFormats": {
+    "integer": 10,
+    "decimal": 3,
+    "percent": 2,
+    "date": 2,
+    "time": 2,
+    "currency": 2,
+    "phone": 1,
+    "version": 1,
+    "range": 1
+  },
+  "symFreqs": {
+    "word": 60,
+    "snakeCase": 6,
+    "camelCase": 6,
+    "call": 6,
+    "arrow": 3,
+    "notEqual": 3,
+    "and": 3,
+    "or": 2,
+    "include": 1,
+    "variable": 4,
+    "tag": 3,
+    "index": 3,
+    "assign": 4
+  },
+  "theme": "default",
+  "caret": "reverse",
+  "caretBlink": false,
+  "errors": "expected",
+  "extra": false
 }`
 
 const nextSavedConfigExample = `{
-  "version": 2,
+  "version": 23,
   "dict": "german",
+  "lang": "",
+  "source": "words",
+  "gen": "weighted",
+  "corpus": "",
+  "charset": "",
   "strict": false,
+  "wordMode": false,
+  "stopOnError": "off",
+  "correction": "free",
+  "confidence": false,
+  "autoPause": true,
+  "idle": 10,
+  "rounds": 1,
+  "warmUp": false,
+  "history": true,
   "top": 100,
   "count": 20,
   "width": 30,
+  "lines": 3,
   "nums": true,
   "punct": true,
+  "sentences": false,
+  "syms": false,
+  "case": "preserve",
+  "caseWeight": 20,
   "noRepeat": 5,
+  "seed": 0,
   "freqs": {
     "word": 85,
     "number": 7,
@@ -55,8 +129,43 @@ const nextSavedConfigExample = `{
     "braces": 2,
     "parenthesis": 3,
     "colon": 3,
-    "semicolon": 2
-  }
+    "semicolon": 2,
+    "apostrophe": 3,
+    "dash": 2,
+    "hyphen": 2,
+    "ellipsis": 1
+  },
+  "numberFormats": {
+    "integer": 10,
+    "decimal": 3,
+    "percent": 2,
+    "date": 2,
+    "time": 2,
+    "currency": 2,
+    "phone": 1,
+    "version": 1,
+    "range": 1
+  },
+  "symFreqs": {
+    "word": 60,
+    "snakeCase": 6,
+    "camelCase": 6,
+    "call": 6,
+    "arrow": 3,
+    "notEqual": 3,
+    "and": 3,
+    "or": 2,
+    "include": 1,
+    "variable": 4,
+    "tag": 3,
+    "index": 3,
+    "assign": 4
+  },
+  "theme": "default",
+  "caret": "reverse",
+  "caretBlink": false,
+  "errors": "expected",
+  "extra": false
 }`
 
 // This test must be adjusted whenever the config changes,
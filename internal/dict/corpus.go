@@ -0,0 +1,32 @@
+package dict
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+//go:embed corpus
+var corpora embed.FS
+
+// LoadCorpus returns the bundled text of the language, e.g. to train a Markov chain.
+func LoadCorpus(language string) (string, error) {
+	data, err := corpora.ReadFile(path.Join("corpus", language))
+	if err != nil {
+		return "", fmt.Errorf("no corpus of language %q: %w", language, err)
+	}
+
+	return string(data), nil
+}
+
+// LoadCorpusFile returns the plain text of a file.
+func LoadCorpusFile(name string) (string, error) {
+	data, err := os.ReadFile(filepath.Clean(name))
+	if err != nil {
+		return "", fmt.Errorf("read corpus failed: %w", err)
+	}
+
+	return string(data), nil
+}
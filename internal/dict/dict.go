@@ -16,6 +16,15 @@ const (
 	German10K  Dictionary = "german10k"
 )
 
+// Language returns the name of the written language of the dictionary.
+func (d Dictionary) Language() string {
+	if d == German10K {
+		return "german"
+	}
+
+	return "english"
+}
+
 func LoadDict(dict Dictionary, top int) []string {
 	data, err := files.ReadFile(string(dict))
 	if err != nil {
@@ -0,0 +1,82 @@
+package display
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type CaretShape int
+
+// Caret shapes, reverse renders the active cell, all others use the terminal cursor.
+const (
+	CaretReverse CaretShape = iota
+	CaretBlock
+	CaretUnderline
+	CaretBar
+)
+
+type Caret struct {
+	Shape CaretShape
+	Blink bool
+}
+
+type UnknownCaretError struct {
+	Name string
+}
+
+func (e *UnknownCaretError) Error() string {
+	return fmt.Sprintf("unknown caret style %q, available: reverse, block, underline, bar", e.Name)
+}
+
+func CaretShapes() map[string]CaretShape {
+	return map[string]CaretShape{
+		"reverse":   CaretReverse,
+		"block":     CaretBlock,
+		"underline": CaretUnderline,
+		"bar":       CaretBar,
+	}
+}
+
+func ParseCaret(name string, blink bool) (Caret, error) {
+	shape, ok := CaretShapes()[strings.ToLower(name)]
+	if !ok {
+		return Caret{Shape: CaretReverse, Blink: blink}, &UnknownCaretError{Name: name}
+	}
+
+	return Caret{Shape: shape, Blink: blink}, nil
+}
+
+// Cursor reports whether the caret is drawn by the terminal cursor.
+func (c Caret) Cursor() bool {
+	return c.Shape != CaretReverse
+}
+
+// DECSCUSR returns the parameter to set the cursor shape: odd values blink, even ones are steady.
+func (c Caret) DECSCUSR() int {
+	n := 2 * int(c.Shape)
+	if c.Blink {
+		n--
+	}
+
+	return n
+}
+
+func HideCursor(out io.Writer) {
+	_, _ = fmt.Fprint(out, CSI+"?25l")
+}
+
+func ShowCursor(out io.Writer) {
+	_, _ = fmt.Fprint(out, CSI+"?25h")
+}
+
+func SetCursorShape(out io.Writer, n int) {
+	_, _ = fmt.Fprint(out, CSI+strconv.Itoa(n)+" q")
+}
+
+// RestoreCursor shows the cursor with the terminal default shape.
+func RestoreCursor(out io.Writer) {
+	SetCursorShape(out, 0)
+	ShowCursor(out)
+}
@@ -2,32 +2,89 @@ package display
 
 import (
 	"fmt"
-	"io"
+	"strings"
+	"unicode"
 
 	"github.com/dgf/tygo/internal/test"
 )
 
-func ColorCSI(s test.Status) string {
-	switch s {
-	case test.Active:
-		return StyleActive
-	case test.Failed:
-		return StyleFailed
-	case test.Passed:
-		return StylePassed
-	case test.Queued:
-		return ""
-	default:
-		return ""
+type ErrorDisplay int
+
+// Error display modes, the rune shown for a failed cell.
+// Both shows the expected rune followed by the underlined typed one, failed cells take two columns.
+const (
+	ErrorsExpected ErrorDisplay = iota
+	ErrorsTyped
+	ErrorsBoth
+)
+
+type UnknownErrorDisplayError struct {
+	Name string
+}
+
+func (e *UnknownErrorDisplayError) Error() string {
+	return fmt.Sprintf("unknown error display %q, available: expected, typed, both", e.Name)
+}
+
+func ErrorDisplays() map[string]ErrorDisplay {
+	return map[string]ErrorDisplay{
+		"expected": ErrorsExpected,
+		"typed":    ErrorsTyped,
+		"both":     ErrorsBoth,
 	}
 }
 
-func PrintCell(out io.Writer, c *test.Cell) {
-	r := c.Rune
+func ParseErrorDisplay(name string) (ErrorDisplay, error) {
+	e, ok := ErrorDisplays()[strings.ToLower(name)]
+	if !ok {
+		return ErrorsExpected, &UnknownErrorDisplayError{Name: name}
+	}
+
+	return e, nil
+}
+
+func PrintCell(out StyleWriter, p Palette, c *test.Cell) {
+	if !mistyped(c) {
+		printRune(out, p, c.Status, c.Rune)
+
+		return
+	}
+
+	typed := c.Inputs[len(c.Inputs)-1]
+
+	switch p.errors {
+	case ErrorsExpected:
+		printRune(out, p, c.Status, c.Rune)
+	case ErrorsTyped:
+		printRune(out, p, c.Status, typed)
+	case ErrorsBoth:
+		printRune(out, p, c.Status, c.Rune)
+		out.Styled(p.CSI(c.Status)+CSI+"4m", typed)
+	}
+}
+
+// mistyped reports whether a failed cell holds a typed rune other than its own, unlike extra cells.
+func mistyped(c *test.Cell) bool {
+	return c.Status == test.Failed && !c.Extra && len(c.Inputs) > 0
+}
+
+// cellWidth returns the columns of a printed cell.
+func cellWidth(p Palette, c *test.Cell) int {
+	if p.errors == ErrorsBoth && mistyped(c) {
+		return 2
+	}
+
+	return 1
+}
+
+func PrintCaret(out StyleWriter, p Palette, c *test.Cell) {
+	printRune(out, p, test.Active, c.Rune)
+}
 
-	if r == ' ' && c.Status == test.Failed {
-		r = '_'
+func printRune(out StyleWriter, p Palette, s test.Status, r rune) {
+	if unicode.IsSpace(r) && s == test.Failed {
+		r = p.blank
 	}
 
-	_, _ = fmt.Fprint(out, ColorCSI(c.Status)+string(r)+Reset)
+	out.Styled(p.CSI(s), r)
 }
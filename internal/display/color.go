@@ -0,0 +1,255 @@
+package display
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Profile is the color capability of a terminal.
+type Profile int
+
+const (
+	Monochrome Profile = iota
+	ANSI16
+	ANSI256
+	TrueColor
+)
+
+type ColorKind int
+
+const (
+	NoColor ColorKind = iota
+	ANSIColor
+	IndexedColor
+	RGBColor
+)
+
+const (
+	ansiColors    = 16
+	indexedColors = 256
+	cubeOffset    = 16
+	cubeSize      = 6
+	grayOffset    = 232
+	grayBase      = 8
+	grayStep      = 10
+)
+
+type Color struct {
+	Kind  ColorKind
+	Index uint8
+	R     uint8
+	G     uint8
+	B     uint8
+}
+
+type InvalidColorError struct {
+	Value string
+}
+
+func (e *InvalidColorError) Error() string {
+	return fmt.Sprintf("invalid color %q, use a name, an index (0-255) or #rrggbb", e.Value)
+}
+
+func ColorNames() map[string]uint8 {
+	return map[string]uint8{
+		"black":          0,
+		"red":            1,
+		"green":          2,
+		"yellow":         3,
+		"blue":           4,
+		"magenta":        5,
+		"cyan":           6,
+		"white":          7,
+		"bright-black":   8,
+		"bright-red":     9,
+		"bright-green":   10,
+		"bright-yellow":  11,
+		"bright-blue":    12,
+		"bright-magenta": 13,
+		"bright-cyan":    14,
+		"bright-white":   15,
+	}
+}
+
+func ANSI(index uint8) Color {
+	return Color{Kind: ANSIColor, Index: index, R: 0, G: 0, B: 0}
+}
+
+func Indexed(index uint8) Color {
+	return Color{Kind: IndexedColor, Index: index, R: 0, G: 0, B: 0}
+}
+
+func RGB(r, g, b uint8) Color {
+	return Color{Kind: RGBColor, Index: 0, R: r, G: g, B: b}
+}
+
+func ParseColor(value string) (Color, error) {
+	name := strings.ToLower(strings.TrimSpace(value))
+
+	if len(name) == 0 {
+		return Color{Kind: NoColor, Index: 0, R: 0, G: 0, B: 0}, nil
+	}
+
+	if index, ok := ColorNames()[name]; ok {
+		return ANSI(index), nil
+	}
+
+	if code, ok := strings.CutPrefix(name, "#"); ok {
+		rgb, err := hex.DecodeString(code)
+		if err != nil || len(rgb) != 3 {
+			return Color{}, &InvalidColorError{Value: value}
+		}
+
+		return RGB(rgb[0], rgb[1], rgb[2]), nil
+	}
+
+	index, err := strconv.ParseUint(name, 10, 8)
+	if err != nil {
+		return Color{}, &InvalidColorError{Value: value}
+	}
+
+	return Indexed(uint8(index)), nil
+}
+
+// SGR returns the select graphic rendition parameter of the color,
+// degraded to the given profile, or an empty string for no color.
+func (c Color) SGR(p Profile, background bool) string {
+	if c.Kind == NoColor || p == Monochrome {
+		return ""
+	}
+
+	switch {
+	case c.Kind == RGBColor && p == TrueColor:
+		return fmt.Sprintf("%d;2;%d;%d;%d", extendedBase(background), c.R, c.G, c.B)
+	case c.Kind == RGBColor && p == ANSI256:
+		return fmt.Sprintf("%d;5;%d", extendedBase(background), nearestIndexed(c.R, c.G, c.B))
+	case c.Kind == IndexedColor && p != ANSI16:
+		return fmt.Sprintf("%d;5;%d", extendedBase(background), c.Index)
+	case c.Kind == ANSIColor:
+		return ansiSGR(c.Index, background)
+	default:
+		r, g, b := c.rgb()
+
+		return ansiSGR(nearestANSI(r, g, b), background)
+	}
+}
+
+func (c Color) rgb() (uint8, uint8, uint8) {
+	if c.Kind == RGBColor {
+		return c.R, c.G, c.B
+	}
+
+	return indexedRGB(c.Index)
+}
+
+func extendedBase(background bool) int {
+	if background {
+		return 48
+	}
+
+	return 38
+}
+
+func ansiSGR(index uint8, background bool) string {
+	base := 30
+	if index >= 8 {
+		base = 90
+		index -= 8
+	}
+
+	if background {
+		base += 10
+	}
+
+	return strconv.Itoa(base + int(index))
+}
+
+// xterm default values of the 16 ANSI colors.
+func ansiPalette() [ansiColors][3]uint8 {
+	return [ansiColors][3]uint8{
+		{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+		{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+		{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+		{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+	}
+}
+
+func cubeLevels() [cubeSize]uint8 {
+	return [cubeSize]uint8{0, 95, 135, 175, 215, 255}
+}
+
+func indexedRGB(index uint8) (uint8, uint8, uint8) {
+	switch {
+	case index < ansiColors:
+		c := ansiPalette()[index]
+
+		return c[0], c[1], c[2]
+	case index < grayOffset:
+		i := int(index) - cubeOffset
+		levels := cubeLevels()
+
+		return levels[i/(cubeSize*cubeSize)], levels[(i/cubeSize)%cubeSize], levels[i%cubeSize]
+	default:
+		gray := uint8(grayBase + grayStep*(int(index)-grayOffset))
+
+		return gray, gray, gray
+	}
+}
+
+func nearestIndexed(r, g, b uint8) uint8 {
+	best, bestDist := uint8(0), -1
+
+	for i := cubeOffset; i < indexedColors; i++ {
+		ir, ig, ib := indexedRGB(uint8(i))
+
+		if d := distance(r, g, b, ir, ig, ib); bestDist < 0 || d < bestDist {
+			best, bestDist = uint8(i), d
+		}
+	}
+
+	return best
+}
+
+func nearestANSI(r, g, b uint8) uint8 {
+	best, bestDist := uint8(0), -1
+
+	for i, c := range ansiPalette() {
+		if d := distance(r, g, b, c[0], c[1], c[2]); bestDist < 0 || d < bestDist {
+			best, bestDist = uint8(i), d
+		}
+	}
+
+	return best
+}
+
+func distance(r1, g1, b1, r2, g2, b2 uint8) int {
+	dr, dg, db := int(r1)-int(r2), int(g1)-int(g2), int(b1)-int(b2)
+
+	return dr*dr + dg*dg + db*db
+}
+
+// DetectProfile derives the color profile from the environment,
+// respecting the NO_COLOR convention (https://no-color.org).
+func DetectProfile(getenv func(key string) string) Profile {
+	if len(getenv("NO_COLOR")) > 0 {
+		return Monochrome
+	}
+
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+
+	termName := getenv("TERM")
+
+	switch {
+	case termName == "dumb":
+		return Monochrome
+	case strings.Contains(termName, "256color"):
+		return ANSI256
+	default:
+		return ANSI16
+	}
+}
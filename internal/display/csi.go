@@ -6,12 +6,6 @@ const (
 	CSI             = "\033["
 	Reset           = CSI + "0m"
 	EraseLineToEnd  = CSI + "2K"
+	EraseLineRight  = CSI + "0K"
 	EraseRightBelow = CSI + "0J"
 )
-
-// Text styles.
-const (
-	StylePassed = CSI + "2m"
-	StyleActive = CSI + "7m"
-	StyleFailed = CSI + "38;5;197m"
-)
@@ -14,6 +14,10 @@ func CursorDown(out io.Writer, n int) {
 	_, _ = fmt.Fprint(out, CSI+strconv.Itoa(n)+"B")
 }
 
+func CursorForward(out io.Writer, n int) {
+	_, _ = fmt.Fprint(out, CSI+strconv.Itoa(n)+"C")
+}
+
 func CursorUp(out io.Writer, n int) {
 	_, _ = fmt.Fprint(out, CSI+strconv.Itoa(n)+"A")
 }
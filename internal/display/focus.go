@@ -0,0 +1,15 @@
+package display
+
+import (
+	"fmt"
+	"io"
+)
+
+// ReportFocus enables or disables the terminal focus in and out sequences.
+func ReportFocus(out io.Writer, enable bool) {
+	if enable {
+		_, _ = fmt.Fprint(out, CSI+"?1004h")
+	} else {
+		_, _ = fmt.Fprint(out, CSI+"?1004l")
+	}
+}
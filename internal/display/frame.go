@@ -0,0 +1,67 @@
+package display
+
+import (
+	"fmt"
+	"io"
+)
+
+// StyleWriter writes plain output and styled runes.
+type StyleWriter interface {
+	io.Writer
+	Styled(style string, r rune)
+}
+
+// Frame buffers the output until it's flushed with one write.
+// Consecutive cells of the same style share one style sequence.
+type Frame struct {
+	out   io.Writer
+	buf   []byte
+	style string
+}
+
+func NewFrame(out io.Writer) *Frame {
+	return &Frame{out: out, buf: []byte{}, style: ""}
+}
+
+// Write appends unstyled output, e.g. cursor movements and plain text.
+func (f *Frame) Write(p []byte) (int, error) {
+	f.unstyle()
+	f.buf = append(f.buf, p...)
+
+	return len(p), nil
+}
+
+// Styled appends a rune, switching the style only if it differs from the previous one.
+func (f *Frame) Styled(style string, r rune) {
+	if style != f.style {
+		f.unstyle()
+		f.buf = append(f.buf, style...)
+		f.style = style
+	}
+
+	f.buf = append(f.buf, string(r)...)
+}
+
+func (f *Frame) Flush() error {
+	f.unstyle()
+
+	if len(f.buf) == 0 {
+		return nil
+	}
+
+	_, err := f.out.Write(f.buf)
+	f.buf = f.buf[:0]
+
+	if err != nil {
+		return fmt.Errorf("frame flush failed: %w", err)
+	}
+
+	return nil
+}
+
+func (f *Frame) unstyle() {
+	if len(f.style) > 0 {
+		f.buf = append(f.buf, Reset...)
+		f.style = ""
+	}
+}
@@ -0,0 +1,130 @@
+package display_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/dgf/tygo/internal/display"
+	"github.com/dgf/tygo/internal/test"
+)
+
+type countingWriter struct {
+	writes int
+	bytes  int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	w.bytes += len(p)
+
+	return len(p), nil
+}
+
+// unbatched writes every styled rune at once, with its own style and reset sequence, like the output before frames.
+type unbatched struct {
+	io.Writer
+}
+
+func (d unbatched) Styled(style string, r rune) {
+	_, _ = fmt.Fprint(d.Writer, style+string(r)+display.Reset)
+}
+
+func fullGrid() test.Grid {
+	words := strings.Fields(strings.Repeat("the quick brown fox jumps over the lazy dog ", 10))
+	grid := test.ToGrid(49, words)
+
+	for r, row := range grid {
+		for c, cell := range row {
+			switch {
+			case r < len(grid)/2 && c%7 == 3:
+				cell.Status = test.Failed
+			case r < len(grid)/2:
+				cell.Status = test.Passed
+			}
+		}
+	}
+
+	return grid
+}
+
+func palette() display.Palette {
+	return display.Themes()["default"].Palette(display.ANSI256)
+}
+
+func TestFrame_PrintGrid(t *testing.T) {
+	t.Parallel()
+
+	grid := fullGrid()
+
+	direct := &countingWriter{writes: 0, bytes: 0}
+	display.PrintGrid(unbatched{Writer: direct}, palette(), grid)
+
+	batched := &countingWriter{writes: 0, bytes: 0}
+	frame := display.NewFrame(batched)
+	display.PrintGrid(frame, palette(), grid)
+
+	err := frame.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if batched.writes != 1 {
+		t.Errorf("expected one write per frame, got: %d", batched.writes)
+	}
+
+	if batched.bytes >= direct.bytes/2 {
+		t.Errorf("expected less than half of %d bytes, got: %d", direct.bytes, batched.bytes)
+	}
+}
+
+func TestFrame_Styled(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	frame := display.NewFrame(&out)
+	frame.Styled("<a>", 'f')
+	frame.Styled("<a>", 'o')
+	frame.Styled("", ' ')
+	frame.Styled("<b>", 'x')
+	_, _ = io.WriteString(frame, "!")
+
+	err := frame.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "<a>fo" + display.Reset + " <b>x" + display.Reset + "!"
+	if out.String() != want {
+		t.Errorf("want: %q, got: %q", want, out.String())
+	}
+}
+
+func BenchmarkPrintGrid_Direct(b *testing.B) {
+	grid := fullGrid()
+	out := &countingWriter{writes: 0, bytes: 0}
+
+	for b.Loop() {
+		display.PrintGrid(unbatched{Writer: out}, palette(), grid)
+	}
+
+	b.ReportMetric(float64(out.writes)/float64(b.N), "writes/op")
+	b.ReportMetric(float64(out.bytes)/float64(b.N), "bytes/op")
+}
+
+func BenchmarkPrintGrid_Frame(b *testing.B) {
+	grid := fullGrid()
+	out := &countingWriter{writes: 0, bytes: 0}
+	frame := display.NewFrame(out)
+
+	for b.Loop() {
+		display.PrintGrid(frame, palette(), grid)
+
+		_ = frame.Flush()
+	}
+
+	b.ReportMetric(float64(out.writes)/float64(b.N), "writes/op")
+	b.ReportMetric(float64(out.bytes)/float64(b.N), "bytes/op")
+}
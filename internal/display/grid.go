@@ -7,10 +7,10 @@ import (
 	"github.com/dgf/tygo/internal/test"
 )
 
-func PrintGrid(out io.Writer, grid test.Grid) {
+func PrintGrid(out StyleWriter, p Palette, grid test.Grid) {
 	for _, row := range grid {
 		for _, cell := range row {
-			PrintCell(out, cell)
+			PrintCell(out, p, cell)
 		}
 
 		NewLine(out)
@@ -1,68 +1,252 @@
 package display
 
 import (
+	"fmt"
 	"io"
+	"slices"
 
 	"github.com/dgf/tygo/internal/test"
 )
 
-type Renderer struct {
-	out  io.Writer
-	row  int
-	rows int
+type Options struct {
+	Palette Palette
+	Caret   Caret
+	Lines   int // visible rows of the grid, all if less than one
 }
 
-func NewRenderer(out io.Writer) *Renderer {
-	return &Renderer{out: out, row: 0, rows: 0}
+type Renderer struct {
+	out     *Frame
+	palette Palette
+	caret   Caret
+	lines   int
+	grid    test.Grid
+	top     int // first visible row
+	row     int
+	col     int
 }
 
-func (r *Renderer) Advance(cell *test.Cell, lineBreak bool) {
-	if cell != nil {
-		PrintCell(r.out, cell)
+func NewRenderer(out io.Writer, opts Options) *Renderer {
+	palette := opts.Palette
+	if opts.Caret.Cursor() {
+		palette = palette.Restyle(test.Active, test.Queued)
 	}
 
-	if lineBreak {
-		r.row++
-		NewLine(r.out)
+	return &Renderer{
+		out:     NewFrame(out),
+		palette: palette,
+		caret:   opts.Caret,
+		lines:   opts.Lines,
+		grid:    nil,
+		top:     0,
+		row:     0,
+		col:     0,
 	}
 }
 
+// Advance prints the typed cells and moves the cursor to the given position.
+func (r *Renderer) Advance(cells test.Cells, row, col int) {
+	if r.palette.errors == ErrorsBoth || slices.ContainsFunc(cells, isExtra) {
+		r.redrawRow()
+	} else {
+		r.printCells(cells)
+	}
+
+	r.moveTo(row, col)
+	r.printCaret()
+}
+
 func (r *Renderer) Exit() {
 	UndoLine(r.out)
+	RestoreCursor(r.out)
+}
+
+// Flush writes the pending output of the current frame.
+func (r *Renderer) Flush() {
+	_ = r.out.Flush()
 }
 
 func (r *Renderer) Next(grid test.Grid) {
 	UndoLine(r.out)
 	PrintLine(r.out, "---")
-	PrintGrid(r.out, grid)
+	r.printGrid(grid)
+}
+
+// Pause hides the text to prevent previewing.
+func (r *Renderer) Pause() {
+	ResetGrid(r.out, r.row-r.top)
+	_, _ = fmt.Fprint(r.out, "paused, press any key to resume\r")
+
+	r.row = r.top
+	r.col = 0
+}
+
+func (r *Renderer) Resume(row, col int) {
+	r.redraw(row)
+	r.moveTo(row, col)
+	r.printCaret()
+}
+
+func (r *Renderer) Print(result test.Result, err error) {
+	r.leaveGrid()
+	PrintResult(r.out, result, err)
+}
+
+// PrintSummary prints the table of all rounds of a finished set, after its last round.
+func (r *Renderer) PrintSummary(summary test.Summary, err error) {
+	r.leaveGrid()
+	PrintSummary(r.out, summary, err)
+}
 
+func (r *Renderer) Reset(grid test.Grid) {
+	ResetGrid(r.out, r.row-r.top)
+	r.printGrid(grid)
+}
+
+// Retract prints the retracted cells, starting with the active one at the given position.
+func (r *Renderer) Retract(cells test.Cells, row, col int) {
+	switch {
+	case row != r.row || slices.ContainsFunc(cells, isExtra):
+		r.redraw(row)
+		r.moveTo(row, col)
+	case r.palette.errors == ErrorsBoth: // cell widths change
+		r.redrawRow()
+		r.moveTo(row, col)
+	default:
+		r.moveTo(row, col)
+		r.printCells(cells)
+		r.moveTo(row, col)
+	}
+
+	r.printCaret()
+}
+
+func (r *Renderer) printGrid(grid test.Grid) {
+	r.grid = grid
+	r.top = 0
 	r.row = 0
-	r.rows = len(grid)
+	r.col = 0
+
+	r.printViewport()
+
+	if r.caret.Cursor() {
+		SetCursorShape(r.out, r.caret.DECSCUSR())
+	} else {
+		HideCursor(r.out)
+	}
+
+	r.printCaret()
 }
 
-func (r *Renderer) Print(result test.Result) {
-	skip := r.rows - r.row
+// leaveGrid moves the cursor to the last visible row and shows it for the output below.
+func (r *Renderer) leaveGrid() {
+	skip := r.height() - (r.row - r.top)
 	if skip > 1 {
 		CursorDown(r.out, skip-1)
 	}
 
-	PrintResult(r.out, result)
+	ShowCursor(r.out)
 }
 
-func (r *Renderer) Reset(grid test.Grid) {
-	ResetGrid(r.out, r.row)
-	PrintGrid(r.out, grid)
+func (r *Renderer) printCells(cells test.Cells) {
+	for _, c := range cells {
+		PrintCell(r.out, r.palette, c)
+	}
 
-	r.row = 0
-	r.rows = len(grid)
+	r.col += len(cells)
 }
 
-func (r *Renderer) Retract(cells test.Cells) {
-	CursorBack(r.out, len(cells)-1)
+func (r *Renderer) height() int {
+	if r.lines < 1 {
+		return len(r.grid)
+	}
 
-	for _, c := range cells {
-		PrintCell(r.out, c)
+	return min(r.lines, len(r.grid))
+}
+
+// topFor keeps the row on the second visible line, as long as rows follow.
+func (r *Renderer) topFor(row int) int {
+	return max(0, min(row-min(1, r.height()-1), len(r.grid)-r.height()))
+}
+
+func (r *Renderer) printViewport() {
+	PrintGrid(r.out, r.palette, r.grid[r.top:r.top+r.height()])
+}
+
+// redraw prints the viewport for the row again, the cursor ends at its start.
+func (r *Renderer) redraw(row int) {
+	ResetGrid(r.out, r.row-r.top)
+
+	r.top = r.topFor(row)
+	r.row = r.top
+	r.col = 0
+
+	r.printViewport()
+}
+
+// moveTo places the cursor at a grid position and scrolls the viewport if needed.
+func (r *Renderer) moveTo(row, col int) {
+	if r.topFor(row) != r.top {
+		r.redraw(row)
+	}
+
+	switch {
+	case row > r.row:
+		CursorDown(r.out, row-r.row)
+	case row < r.row:
+		CursorUp(r.out, r.row-row)
+	}
+
+	if row != r.row || col != r.col {
+		_, _ = fmt.Fprint(r.out, "\r")
+
+		if column := r.column(row, col); column > 0 {
+			CursorForward(r.out, column)
+		}
+	}
+
+	r.row = row
+	r.col = col
+}
+
+// column returns the screen column of a cell, cells before may be wider than one column.
+func (r *Renderer) column(row, col int) int {
+	column := 0
+
+	for _, c := range r.grid[row][:col] {
+		column += cellWidth(r.palette, c)
+	}
+
+	return column
+}
+
+func isExtra(c *test.Cell) bool {
+	return c.Extra
+}
+
+// redrawRow prints the current row again, e.g. after extra cells got inserted or removed.
+func (r *Renderer) redrawRow() {
+	_, _ = fmt.Fprint(r.out, "\r")
+
+	for _, c := range r.grid[r.row] {
+		PrintCell(r.out, r.palette, c)
+	}
+
+	_, _ = fmt.Fprint(r.out, EraseLineRight+"\r")
+
+	r.col = 0
+}
+
+// printCaret highlights the active cell, unless the terminal cursor is used.
+func (r *Renderer) printCaret() {
+	if r.caret.Cursor() || r.row >= len(r.grid) || r.col >= len(r.grid[r.row]) {
+		return
+	}
+
+	cell := r.grid[r.row][r.col]
+	if cell == nil {
+		return
 	}
 
-	CursorBack(r.out, len(cells))
+	PrintCaret(r.out, r.palette, cell)
+	CursorBack(r.out, 1)
 }
@@ -0,0 +1,121 @@
+package display_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dgf/tygo/internal/display"
+	"github.com/dgf/tygo/internal/test"
+)
+
+func newRenderer(out *strings.Builder, p display.Palette, shape display.CaretShape, lines int) *display.Renderer {
+	return display.NewRenderer(out, display.Options{
+		Palette: p,
+		Caret:   display.Caret{Shape: shape, Blink: false},
+		Lines:   lines,
+	})
+}
+
+var escapes = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// printed returns the words of the output without escape sequences.
+func printed(out *strings.Builder) string {
+	return strings.Join(strings.Fields(escapes.ReplaceAllString(out.String(), "")), " ")
+}
+
+func TestRenderer_ErrorsBoth(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	p := display.MonoTheme().Palette(display.Monochrome).ShowErrors(display.ErrorsBoth)
+	r := newRenderer(&out, p, display.CaretReverse, 0)
+	grid := test.ToGrid(20, []string{"ab", "cd"})
+
+	r.Reset(grid)
+	r.Flush()
+	out.Reset()
+
+	a := grid[0][0]
+	a.Inputs = []rune{'x'}
+	a.Status = test.Failed
+	grid[0][1].Status = test.Active
+
+	r.Advance(test.Cells{a}, 0, 1)
+	r.Flush()
+
+	failed := p.CSI(test.Failed)
+	if expected := failed + "a" + display.Reset + failed + display.CSI + "4mx"; !strings.Contains(out.String(), expected) {
+		t.Errorf("expected rune followed by the marked typed one %q, got: %q", expected, out.String())
+	}
+
+	if !strings.Contains(out.String(), "\r"+display.CSI+"2C") {
+		t.Errorf("expected the caret behind both columns of the failed cell, got: %q", out.String())
+	}
+}
+
+func TestRenderer_Viewport(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		lines    int
+		rows     []int // moved to in order
+		expected string
+	}{
+		{"second row stays", 2, []int{1}, ""},
+		{"third row scrolls", 2, []int{1, 2}, "bb cc"},
+		{"last row", 2, []int{4}, "dd ee"},
+		{"row back on second line", 2, []int{4, 3}, "cc dd"},
+		{"back to first", 2, []int{4, 0}, "aa bb"},
+		{"one line", 1, []int{2}, "cc"},
+		{"all lines", 0, []int{4}, ""},
+		{"more lines than rows", 9, []int{4}, ""},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out strings.Builder
+
+			r := newRenderer(&out, display.MonoTheme().Palette(display.Monochrome), display.CaretBar, testCase.lines)
+			r.Reset(test.ToGrid(4, []string{"aa", "bb", "cc", "dd", "ee"}))
+
+			for i, row := range testCase.rows {
+				if i == len(testCase.rows)-1 {
+					r.Flush()
+					out.Reset()
+				}
+
+				r.Advance(nil, row, 0)
+			}
+
+			r.Flush()
+
+			if actual := printed(&out); actual != testCase.expected {
+				t.Errorf("expected redrawn rows %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRenderer_ResumeViewport(t *testing.T) {
+	t.Parallel()
+
+	for row, expected := range []string{"aa bb", "aa bb", "bb cc", "cc dd", "dd ee"} {
+		var out strings.Builder
+
+		r := newRenderer(&out, display.MonoTheme().Palette(display.Monochrome), display.CaretBar, 2)
+		r.Reset(test.ToGrid(4, []string{"aa", "bb", "cc", "dd", "ee"}))
+		r.Pause()
+		r.Flush()
+		out.Reset()
+
+		r.Resume(row, 0)
+		r.Flush()
+
+		if actual := printed(&out); actual != expected {
+			t.Errorf("expected rows %q resumed at row %d, got: %q", expected, row, actual)
+		}
+	}
+}
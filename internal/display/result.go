@@ -3,16 +3,81 @@ package display
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dgf/tygo/internal/test"
 )
 
-func PrintResult(out io.Writer, result test.Result) {
+// ResultLines is the height of the result below the grid, including an error and the separator of the next test.
+const ResultLines = 14
+
+// summaryLines is the height of a summary without its rounds, like ResultLines.
+const summaryLines = 12
+
+// SummaryLines is the height of the summary of a set with the rounds, like ResultLines.
+func SummaryLines(rounds int) int {
+	return summaryLines + rounds
+}
+
+// PrintResult prints the result, an error of the finished test is shown above the prompt.
+func PrintResult(out io.Writer, result test.Result, err error) {
 	NewLine(out)
 	NewLine(out)
 
 	_, _ = fmt.Fprintf(out, "Result: %s", result)
 
+	printError(out, err)
+	printPrompt(out)
+}
+
+// PrintSummary prints a table of all rounds and their aggregates.
+func PrintSummary(out io.Writer, summary test.Summary, err error) {
+	NewLine(out)
+	NewLine(out)
+
+	header := fmt.Sprintf("Rounds: %d", len(summary.Rounds))
+	if summary.WarmUp && len(summary.Rounds) > 1 {
+		header += " (first as warm-up)"
+	}
+
+	PrintLine(out, header)
+	rows := []string{"        WPM  ACC  AWPM"}
+
+	for i, r := range summary.Rounds {
+		label := fmt.Sprintf("%5d", i+1)
+		if i == 0 && len(summary.Counted()) < len(summary.Rounds) {
+			label = "warm "
+		}
+
+		rows = append(rows, summaryRow(label, r))
+	}
+
+	rows = append(rows,
+		summaryRow("Mean ", summary.Mean),
+		summaryRow("Best ", summary.Best),
+		summaryRow("Worst", summary.Worst))
+
+	_, _ = fmt.Fprint(out, strings.Join(rows, "\r\n"))
+
+	printError(out, err)
+	printPrompt(out)
+}
+
+func summaryRow(label string, r test.Result) string {
+	return fmt.Sprintf("%s %5d %4d%% %5d", label, r.WordsPerMinute, r.AccuracyPercent, r.AdjustedWordsPerMinute)
+}
+
+func printError(out io.Writer, err error) {
+	if err == nil {
+		return
+	}
+
+	NewLine(out)
+
+	_, _ = fmt.Fprintf(out, "Error: %v", err)
+}
+
+func printPrompt(out io.Writer) {
 	NewLine(out)
 	NewLine(out)
 
@@ -0,0 +1,44 @@
+package display_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgf/tygo/internal/display"
+	"github.com/dgf/tygo/internal/test"
+)
+
+func TestResultLines(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	// the tallest result, with all optional lines
+	result := test.Result{
+		Mode: test.ModeConfidence, Duration: time.Minute, WordsPerMinute: 60, AccuracyPercent: 100,
+		AdjustedWordsPerMinute: 60, Words: 60, ErrorWords: 0, Idle: time.Second, Seed: 1, Code: "1.AA",
+	}
+	display.PrintResult(&out, result, errors.New("failed"))
+
+	// the separator above the next grid and the cursor line below the prompt
+	if lines := strings.Count(out.String(), "\n") + 2; lines != display.ResultLines {
+		t.Errorf("expected %d result lines, got: %d", display.ResultLines, lines)
+	}
+}
+
+func TestSummaryLines(t *testing.T) {
+	t.Parallel()
+
+	for _, rounds := range []int{2, 5} {
+		var out strings.Builder
+
+		results := make([]test.Result, rounds)
+		display.PrintSummary(&out, test.Summarize(results, true), errors.New("failed"))
+
+		if lines := strings.Count(out.String(), "\n") + 2; lines != display.SummaryLines(rounds) {
+			t.Errorf("expected %d summary lines of %d rounds, got: %d", display.SummaryLines(rounds), rounds, lines)
+		}
+	}
+}
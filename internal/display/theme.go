@@ -0,0 +1,233 @@
+package display
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/dgf/tygo/internal/test"
+)
+
+type Attr int
+
+// Text attributes.
+const (
+	Bold Attr = 1 << iota
+	Dim
+	Italic
+	Underline
+	Reverse
+	Strike
+)
+
+type Style struct {
+	Fg    Color
+	Bg    Color
+	Attrs Attr
+}
+
+// Theme maps each cell status to a style, missing entries are rendered unstyled.
+type Theme map[test.Status]Style
+
+// Palette holds the resolved CSI sequences per cell status.
+type Palette struct {
+	styles map[test.Status]string
+	blank  rune         // shown for a failed space, if its style isn't visible
+	errors ErrorDisplay // runes shown for failed cells
+}
+
+type UnknownAttrError struct {
+	Name string
+}
+
+func (e *UnknownAttrError) Error() string {
+	return fmt.Sprintf("unknown text attribute %q", e.Name)
+}
+
+type UnknownStatusError struct {
+	Name string
+}
+
+func (e *UnknownStatusError) Error() string {
+	return fmt.Sprintf("unknown cell status %q", e.Name)
+}
+
+type UnknownThemeError struct {
+	Name string
+}
+
+func (e *UnknownThemeError) Error() string {
+	return fmt.Sprintf("unknown theme %q", e.Name)
+}
+
+func AttrNames() map[string]Attr {
+	return map[string]Attr{
+		"bold":      Bold,
+		"dim":       Dim,
+		"italic":    Italic,
+		"underline": Underline,
+		"reverse":   Reverse,
+		"strike":    Strike,
+	}
+}
+
+func AttrCodes() map[Attr]int {
+	return map[Attr]int{
+		Bold:      1,
+		Dim:       2,
+		Italic:    3,
+		Underline: 4,
+		Reverse:   7,
+		Strike:    9,
+	}
+}
+
+func StatusNames() map[string]test.Status {
+	return map[string]test.Status{
+		"queued": test.Queued,
+		"failed": test.Failed,
+		"passed": test.Passed,
+		"active": test.Active,
+		"missed": test.Missed,
+	}
+}
+
+func ParseStatus(name string) (test.Status, error) {
+	status, ok := StatusNames()[strings.ToLower(name)]
+	if !ok {
+		return status, &UnknownStatusError{Name: name}
+	}
+
+	return status, nil
+}
+
+func ParseStyle(fg, bg string, attrs []string) (Style, error) {
+	style := Style{}
+
+	var err error
+
+	style.Fg, err = ParseColor(fg)
+	if err != nil {
+		return style, fmt.Errorf("foreground: %w", err)
+	}
+
+	style.Bg, err = ParseColor(bg)
+	if err != nil {
+		return style, fmt.Errorf("background: %w", err)
+	}
+
+	for _, name := range attrs {
+		attr, ok := AttrNames()[strings.ToLower(name)]
+		if !ok {
+			return style, &UnknownAttrError{Name: name}
+		}
+
+		style.Attrs |= attr
+	}
+
+	return style, nil
+}
+
+// CSI returns the escape sequence to render the style with the given profile.
+func (s Style) CSI(p Profile) string {
+	params := []string{}
+
+	for attr := Bold; attr <= Strike; attr <<= 1 {
+		if s.Attrs&attr != 0 {
+			params = append(params, strconv.Itoa(AttrCodes()[attr]))
+		}
+	}
+
+	if fg := s.Fg.SGR(p, false); len(fg) > 0 {
+		params = append(params, fg)
+	}
+
+	if bg := s.Bg.SGR(p, true); len(bg) > 0 {
+		params = append(params, bg)
+	}
+
+	if len(params) == 0 {
+		return ""
+	}
+
+	return CSI + strings.Join(params, ";") + "m"
+}
+
+func (t Theme) Palette(p Profile) Palette {
+	styles := make(map[test.Status]string, len(t))
+
+	for status, style := range t {
+		styles[status] = style.CSI(p)
+	}
+
+	blank := '_'
+	if failed := t[test.Failed]; failed.Attrs&(Underline|Reverse|Strike) != 0 ||
+		(failed.Bg.Kind != NoColor && p != Monochrome) {
+		blank = ' '
+	}
+
+	return Palette{styles: styles, blank: blank, errors: ErrorsExpected}
+}
+
+func (p Palette) CSI(s test.Status) string {
+	return p.styles[s]
+}
+
+// Restyle returns a copy of the palette that renders the status like another one.
+func (p Palette) Restyle(status, like test.Status) Palette {
+	styles := maps.Clone(p.styles)
+	styles[status] = p.styles[like]
+
+	return Palette{styles: styles, blank: p.blank, errors: p.errors}
+}
+
+// ShowErrors returns a copy of the palette that renders failed cells as configured.
+func (p Palette) ShowErrors(e ErrorDisplay) Palette {
+	return Palette{styles: p.styles, blank: p.blank, errors: e}
+}
+
+func Themes() map[string]Theme {
+	return map[string]Theme{
+		"default": {
+			test.Passed: {Fg: Color{}, Bg: Color{}, Attrs: Dim},
+			test.Active: {Fg: Color{}, Bg: Color{}, Attrs: Reverse},
+			test.Failed: {Fg: Indexed(197), Bg: Color{}, Attrs: 0},
+			test.Missed: {Fg: Indexed(197), Bg: Color{}, Attrs: Dim | Underline},
+		},
+		"mono": {
+			test.Passed: {Fg: Color{}, Bg: Color{}, Attrs: Dim},
+			test.Active: {Fg: Color{}, Bg: Color{}, Attrs: Reverse},
+			test.Failed: {Fg: Color{}, Bg: Color{}, Attrs: Bold | Underline},
+			test.Missed: {Fg: Color{}, Bg: Color{}, Attrs: Dim | Underline},
+		},
+		"solarized": {
+			test.Queued: {Fg: RGB(0x83, 0x94, 0x96), Bg: Color{}, Attrs: 0},
+			test.Passed: {Fg: RGB(0x58, 0x6e, 0x75), Bg: Color{}, Attrs: 0},
+			test.Active: {Fg: RGB(0xfd, 0xf6, 0xe3), Bg: RGB(0x26, 0x8b, 0xd2), Attrs: 0},
+			test.Failed: {Fg: RGB(0xdc, 0x32, 0x2f), Bg: Color{}, Attrs: Underline},
+			test.Missed: {Fg: RGB(0xcb, 0x4b, 0x16), Bg: Color{}, Attrs: Underline},
+		},
+		"contrast": {
+			test.Queued: {Fg: ANSI(7), Bg: Color{}, Attrs: 0},
+			test.Passed: {Fg: ANSI(10), Bg: Color{}, Attrs: 0},
+			test.Active: {Fg: ANSI(0), Bg: ANSI(11), Attrs: Bold},
+			test.Failed: {Fg: ANSI(15), Bg: ANSI(9), Attrs: Bold},
+			test.Missed: {Fg: ANSI(9), Bg: Color{}, Attrs: Underline},
+		},
+	}
+}
+
+// MonoTheme is used without color support, it relies on text attributes only.
+func MonoTheme() Theme {
+	return Themes()["mono"]
+}
+
+func LoadTheme(name string) (Theme, error) {
+	theme, ok := Themes()[name]
+	if !ok {
+		return nil, &UnknownThemeError{Name: name}
+	}
+
+	return theme, nil
+}
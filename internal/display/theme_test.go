@@ -0,0 +1,95 @@
+package display_test
+
+import (
+	"testing"
+
+	"github.com/dgf/tygo/internal/display"
+)
+
+func TestStyleCSI(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name    string
+		fg      string
+		bg      string
+		attrs   []string
+		profile display.Profile
+		csi     string
+	}{
+		{"none", "", "", nil, display.TrueColor, ""},
+		{"attrs", "", "", []string{"underline", "bold"}, display.ANSI16, "\033[1;4m"},
+		{"named", "red", "bright-blue", nil, display.TrueColor, "\033[31;104m"},
+		{"indexed", "197", "", nil, display.ANSI256, "\033[38;5;197m"},
+		{"indexed to ansi", "196", "", nil, display.ANSI16, "\033[91m"},
+		{"rgb", "#dc322f", "", nil, display.TrueColor, "\033[38;2;220;50;47m"},
+		{"rgb to indexed", "#ff0000", "", nil, display.ANSI256, "\033[38;5;196m"},
+		{"rgb to ansi", "#00cd00", "#000000", nil, display.ANSI16, "\033[32;40m"},
+		{"mono drops colors", "red", "blue", []string{"dim"}, display.Monochrome, "\033[2m"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			style, err := display.ParseStyle(testCase.fg, testCase.bg, testCase.attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if csi := style.CSI(testCase.profile); csi != testCase.csi {
+				t.Errorf("want: %q, got: %q", testCase.csi, csi)
+			}
+		})
+	}
+}
+
+func TestParseStyle_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name  string
+		fg    string
+		attrs []string
+	}{
+		{"name", "purple", nil},
+		{"index", "256", nil},
+		{"hex", "#12345", nil},
+		{"attr", "", []string{"blink"}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			style, err := display.ParseStyle(testCase.fg, "", testCase.attrs)
+			if err == nil {
+				t.Errorf("expected parse error, got: %v", style)
+			}
+		})
+	}
+}
+
+func TestDetectProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name    string
+		env     map[string]string
+		profile display.Profile
+	}{
+		{"no color", map[string]string{"NO_COLOR": "1", "COLORTERM": "truecolor"}, display.Monochrome},
+		{"truecolor", map[string]string{"COLORTERM": "truecolor", "TERM": "xterm"}, display.TrueColor},
+		{"256", map[string]string{"TERM": "xterm-256color"}, display.ANSI256},
+		{"dumb", map[string]string{"TERM": "dumb"}, display.Monochrome},
+		{"fallback", map[string]string{}, display.ANSI16},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := display.DetectProfile(func(key string) string {
+				return testCase.env[key]
+			})
+
+			if profile != testCase.profile {
+				t.Errorf("want: %d, got: %d", testCase.profile, profile)
+			}
+		})
+	}
+}
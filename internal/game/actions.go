@@ -1,15 +1,13 @@
 package game
 
-import "github.com/dgf/tygo/internal/test"
-
 type Action func(s *Session, r Renderer, f SessionFactory) *Session
 
 func BackRune(s *Session, r Renderer, _ SessionFactory) *Session {
 	if !s.Done() {
-		curr, next := s.RetractRune()
+		cells := s.RetractRune()
 
-		if curr != nil {
-			r.Retract(test.Cells{curr, next})
+		if len(cells) > 0 {
+			r.Retract(cells, s.Row(), s.Col())
 		}
 	}
 
@@ -21,7 +19,7 @@ func BackWord(s *Session, r Renderer, _ SessionFactory) *Session {
 		cells := s.RetractWord()
 
 		if len(cells) > 0 {
-			r.Retract(cells)
+			r.Retract(cells, s.Row(), s.Col())
 		}
 	}
 
@@ -43,6 +41,14 @@ func Next(s *Session, r Renderer, f SessionFactory) *Session {
 	return n
 }
 
+func Pause(s *Session, r Renderer, _ SessionFactory) *Session {
+	if s.Pause() {
+		r.Pause()
+	}
+
+	return s
+}
+
 func Quit(s *Session, r Renderer, _ SessionFactory) *Session {
 	if !s.Done() {
 		return s
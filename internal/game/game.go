@@ -2,51 +2,117 @@
 package game
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/dgf/tygo/internal/config"
 	"github.com/dgf/tygo/internal/gen"
 	"github.com/dgf/tygo/internal/test"
 )
 
 type Game struct {
+	actions  map[test.Event]Action
 	factory  SessionFactory
 	renderer Renderer
+	recorder Recorder
+	sharer   Sharer
+	rules    Rules
 	session  *Session
+	seed     int64 // of the current session text
+	rounds   int
+	warmUp   bool
+	results  []test.Result // finished rounds of the current set
+}
+
+// NewGame starts the first session, the recorder and sharer are optional.
+func NewGame(
+	cfg config.Config, rules Rules, source TextSource, renderer Renderer, recorder Recorder, sharer Sharer,
+) *Game {
+	return NewGameWithClock(cfg, rules, source, renderer, recorder, sharer, time.Now)
 }
 
-func NewGame(cfg config.Config, words []string, renderer Renderer) *Game {
-	factory := func() *Session {
-		return newGameSession(cfg, words)
+// NewGameWithClock starts the first session, its sessions are timed by the clock.
+func NewGameWithClock(
+	cfg config.Config, rules Rules, source TextSource, renderer Renderer, recorder Recorder, sharer Sharer, clock Clock,
+) *Game {
+	g := &Game{
+		actions:  EventActions(rules),
+		factory:  nil,
+		renderer: renderer,
+		recorder: recorder,
+		sharer:   sharer,
+		rules:    rules,
+		session:  nil,
+		seed:     0,
+		rounds:   max(1, cfg.Rounds),
+		warmUp:   cfg.WarmUp,
+		results:  nil,
 	}
 
-	session := factory()
-	renderer.Reset(session.Grid())
+	g.factory = func() *Session {
+		g.seed = cfg.Seed
+		if g.seed == 0 {
+			g.seed = gen.RandomSeed()
+		}
 
-	return &Game{
-		factory:  factory,
-		renderer: renderer,
-		session:  session,
+		return NewSessionWithClock(rules, source.Text(g.seed).ToGrid(cfg.Width-1), clock)
 	}
+
+	g.session = g.factory()
+	renderer.Reset(g.session.Grid())
+	renderer.Flush()
+
+	return g
 }
 
-func EventActions() map[test.Event]Action {
-	return map[test.Event]Action{
+// EventActions maps the events to actions, in confidence mode without backspace,
+// unless stopping on word errors that requires to fix the failed word.
+func EventActions(rules Rules) map[test.Event]Action {
+	actions := map[test.Event]Action{
 		test.EventBackRune: BackRune,
 		test.EventBackWord: BackWord,
 		test.EventExit:     Exit,
 		test.EventNext:     Next,
 		test.EventQuit:     Quit,
 		test.EventReset:    Reset,
+		test.EventPause:    Pause,
 	}
+
+	if rules.Confidence && rules.Stop != StopWord {
+		delete(actions, test.EventBackRune)
+		delete(actions, test.EventBackWord)
+	}
+
+	if rules.AutoPause {
+		actions[test.EventFocusOut] = Pause
+	}
+
+	return actions
 }
 
+// HandleEvent dispatches the event, any key event resumes a paused session.
 func (g *Game) HandleEvent(e test.Event) bool {
-	action, ok := EventActions()[e]
+	if g.session.Paused() && e != test.EventExit {
+		if e != test.EventFocusIn && e != test.EventFocusOut {
+			g.resume()
+		}
+
+		return false
+	}
+
+	action, ok := g.actions[e]
 
 	if !ok {
 		return false
 	}
 
-	g.session = action(g.session, g.renderer, g.factory)
+	next := action(g.session, g.renderer, g.factory)
+	if e == test.EventReset && next != g.session {
+		g.results = nil // restarts the set
+	}
+
+	g.session = next
+	g.renderer.Flush()
 
 	return g.session == nil
 }
@@ -56,38 +122,60 @@ func (g *Game) HandleRune(r rune) {
 		return
 	}
 
-	cell, br := g.session.Advance(r)
-	g.renderer.Advance(cell, br)
+	defer g.renderer.Flush()
+
+	if g.session.Paused() {
+		g.resume()
+
+		return
+	}
+
+	cells := g.session.Advance(r)
+	g.renderer.Advance(cells, g.session.Row(), g.session.Col())
 
 	if g.session.Done() {
 		result := test.Calc(g.session.Duration(), g.session.Grid())
+		result.Mode = g.rules.Mode()
+		result.Idle = g.session.Idle()
+		result.Seed = g.seed
 
-		g.renderer.Print(result)
+		if g.sharer != nil {
+			result.Code = g.sharer.Share(g.seed)
+		}
+
+		g.finishRound(result)
 	}
 }
 
-func newGameSession(cfg config.Config, words []string) *Session {
-	list := gen.SampleWeightedList(cfg.WordCount, cfg.NoRepeat, words)
+// finishRound prints the result, or the summary and record of a finished set.
+func (g *Game) finishRound(result test.Result) {
+	g.results = append(g.results, result)
+
+	if len(g.results) < g.rounds {
+		g.renderer.Print(result, nil)
 
-	if cfg.Numbers {
-		list = gen.WithNumbers(cfg.Distribution.Number, list)
+		return
 	}
 
-	if cfg.Punctuation {
-		list = gen.PunctuationMarks(list, map[gen.Punctuation]int{
-			gen.Word:        cfg.Distribution.Word,
-			gen.Period:      cfg.Distribution.Period,
-			gen.Comma:       cfg.Distribution.Comma,
-			gen.Quotation:   cfg.Distribution.Quotation,
-			gen.Question:    cfg.Distribution.Question,
-			gen.Exclamation: cfg.Distribution.Exclamation,
-			gen.Brackets:    cfg.Distribution.Brackets,
-			gen.Braces:      cfg.Distribution.Braces,
-			gen.Parenthesis: cfg.Distribution.Parenthesis,
-			gen.Colon:       cfg.Distribution.Colon,
-			gen.Semicolon:   cfg.Distribution.Semicolon,
-		})
+	summary := test.Summarize(g.results, g.warmUp)
+	g.results = nil
+
+	var err error
+	if g.recorder != nil {
+		if err = g.recorder.Record(summary); err != nil {
+			err = fmt.Errorf("history not saved: %w", err)
+		}
 	}
 
-	return NewSession(cfg.StrictMode, test.ToGrid(cfg.Width-1, list))
+	if g.rounds > 1 {
+		g.renderer.PrintSummary(summary, err)
+	} else {
+		g.renderer.Print(result, err)
+	}
+}
+
+func (g *Game) resume() {
+	g.session.Resume()
+	g.renderer.Resume(g.session.Row(), g.session.Col())
+	g.renderer.Flush()
 }
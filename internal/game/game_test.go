@@ -0,0 +1,118 @@
+package game_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgf/tygo/internal/config"
+	"github.com/dgf/tygo/internal/game"
+	"github.com/dgf/tygo/internal/test"
+)
+
+func TestEventActions_Confidence(t *testing.T) {
+	t.Parallel()
+
+	normal := game.EventActions(game.Rules{})
+	confidence := game.EventActions(game.Rules{Confidence: true})
+
+	for _, e := range []test.Event{test.EventBackRune, test.EventBackWord} {
+		if _, ok := normal[e]; !ok {
+			t.Errorf("expected action for event %d in normal mode", e)
+		}
+
+		if _, ok := confidence[e]; ok {
+			t.Errorf("unexpected action for event %d in confidence mode", e)
+		}
+	}
+
+	if len(confidence) != len(normal)-2 {
+		t.Errorf("expected only backspace actions removed, got: %d of %d", len(confidence), len(normal))
+	}
+}
+
+type fakeSource struct{}
+
+func (fakeSource) Text(int64) test.Text {
+	return test.Text{Words: []string{"a"}, Grid: nil}
+}
+
+type fakeRenderer struct {
+	summary *test.Summary
+	err     error
+}
+
+func (*fakeRenderer) Advance(test.Cells, int, int)     {}
+func (*fakeRenderer) Exit()                            {}
+func (*fakeRenderer) Flush()                           {}
+func (*fakeRenderer) Next(test.Grid)                   {}
+func (*fakeRenderer) Pause()                           {}
+func (*fakeRenderer) Reset(test.Grid)                  {}
+func (*fakeRenderer) Resume(int, int)                  {}
+func (*fakeRenderer) Retract(test.Cells, int, int)     {}
+func (r *fakeRenderer) Print(_ test.Result, err error) { r.err = err }
+
+func (r *fakeRenderer) PrintSummary(summary test.Summary, err error) {
+	r.summary = &summary
+	r.err = err
+}
+
+// tickingClock advances a second on every reading, so even a one-letter test takes time.
+type tickingClock struct {
+	now time.Time
+}
+
+func (c *tickingClock) Now() time.Time {
+	c.now = c.now.Add(time.Second)
+
+	return c.now
+}
+
+func newGame(cfg config.Config, renderer game.Renderer, recorder game.Recorder) *game.Game {
+	clock := &tickingClock{now: time.Unix(0, 0)}
+
+	return game.NewGameWithClock(cfg, game.Rules{}, fakeSource{}, renderer, recorder, nil, clock.Now)
+}
+
+type failingRecorder struct{}
+
+func (failingRecorder) Record(test.Summary) error {
+	return errors.New("disk full")
+}
+
+func TestGame_RecordError(t *testing.T) {
+	t.Parallel()
+
+	renderer := &fakeRenderer{summary: nil, err: nil}
+	g := newGame(config.Default(), renderer, failingRecorder{})
+	g.HandleRune('a')
+
+	if renderer.err == nil || !strings.Contains(renderer.err.Error(), "disk full") {
+		t.Errorf("expected record error in the result, got: %v", renderer.err)
+	}
+}
+
+func TestGame_ResetRestartsSet(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Default()
+	cfg.Rounds = 2
+	renderer := &fakeRenderer{summary: nil, err: nil}
+	g := newGame(cfg, renderer, nil)
+
+	g.HandleRune('a')
+	g.HandleEvent(test.EventNext)
+	g.HandleEvent(test.EventReset)
+
+	if g.HandleRune('a'); renderer.summary != nil {
+		t.Fatalf("expected the set restarted by reset, got summary of %d rounds", len(renderer.summary.Rounds))
+	}
+
+	g.HandleEvent(test.EventNext)
+	g.HandleRune('a')
+
+	if renderer.summary == nil || len(renderer.summary.Rounds) != 2 {
+		t.Errorf("expected summary of 2 rounds, got: %v", renderer.summary)
+	}
+}
@@ -0,0 +1,13 @@
+package game
+
+import "github.com/dgf/tygo/internal/test"
+
+// Recorder saves the summary of a finished set of rounds.
+type Recorder interface {
+	Record(summary test.Summary) error
+}
+
+// Sharer returns a code that reproduces the text generated with the seed.
+type Sharer interface {
+	Share(seed int64) string
+}
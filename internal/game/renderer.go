@@ -3,10 +3,14 @@ package game
 import "github.com/dgf/tygo/internal/test"
 
 type Renderer interface {
-	Advance(cell *test.Cell, lineBreak bool)
+	Advance(cells test.Cells, row, col int)
 	Exit()
+	Flush()
 	Next(grid test.Grid)
-	Print(result test.Result)
+	Pause()
+	Print(result test.Result, err error)
+	PrintSummary(summary test.Summary, err error)
 	Reset(grid test.Grid)
-	Retract(cells test.Cells)
+	Resume(row, col int)
+	Retract(cells test.Cells, row, col int)
 }
@@ -0,0 +1,106 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgf/tygo/internal/config"
+	"github.com/dgf/tygo/internal/test"
+)
+
+type Stop int
+
+// Stop modes, letter blocks until the correct key, word blocks space until the word is fixed.
+const (
+	StopOff Stop = iota
+	StopLetter
+	StopWord
+)
+
+type Correction int
+
+// Correction policies, how far backspace may retract.
+const (
+	CorrectFree      Correction = iota // everywhere, also across rows
+	CorrectWord                        // only within the current word
+	CorrectIncorrect                   // into previous words only if they contain errors
+	CorrectNone                        // not at all
+)
+
+// Rules define how a session handles typed runes.
+type Rules struct {
+	Strict     bool // ends the session on the first error
+	Extra      bool // inserts runes typed past the end of a word
+	Words      bool // space skips to the next word, implies extra
+	Stop       Stop
+	Correction Correction
+	Confidence bool          // disables backspace, except to fix a failed word when stopping on it
+	AutoPause  bool          // pauses on terminal focus loss
+	Idle       time.Duration // the time of keystroke gaps beyond is excluded, disabled if zero
+}
+
+type UnknownStopError struct {
+	Name string
+}
+
+func (e *UnknownStopError) Error() string {
+	return fmt.Sprintf("unknown stop on error mode %q, available: off, letter, word", e.Name)
+}
+
+type UnknownCorrectionError struct {
+	Name string
+}
+
+func (e *UnknownCorrectionError) Error() string {
+	return fmt.Sprintf("unknown correction policy %q, available: free, word, incorrect, none", e.Name)
+}
+
+func StopModes() map[string]Stop {
+	return map[string]Stop{
+		"off":    StopOff,
+		"letter": StopLetter,
+		"word":   StopWord,
+	}
+}
+
+func Corrections() map[string]Correction {
+	return map[string]Correction{
+		"free":      CorrectFree,
+		"word":      CorrectWord,
+		"incorrect": CorrectIncorrect,
+		"none":      CorrectNone,
+	}
+}
+
+func ParseRules(cfg config.Config) (Rules, error) {
+	stop, ok := StopModes()[strings.ToLower(cfg.StopOnError)]
+	if !ok {
+		return Rules{}, &UnknownStopError{Name: cfg.StopOnError}
+	}
+
+	correction, ok := Corrections()[strings.ToLower(cfg.Correction)]
+	if !ok {
+		return Rules{}, &UnknownCorrectionError{Name: cfg.Correction}
+	}
+
+	return Rules{
+		Strict:     cfg.StrictMode,
+		Extra:      cfg.Extra,
+		Words:      cfg.WordMode,
+		Stop:       stop,
+		Correction: correction,
+		Confidence: cfg.Confidence,
+		AutoPause:  cfg.AutoPause,
+		Idle:       time.Duration(cfg.IdleSeconds) * time.Second,
+	}, nil
+}
+
+// Mode of results typed with the rules.
+func (r Rules) Mode() test.Mode {
+	if r.Confidence {
+		return test.ModeConfidence
+	}
+
+	return test.ModeNormal
+}
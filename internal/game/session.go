@@ -8,22 +8,39 @@ import (
 )
 
 type Session struct {
-	strict bool
-	row    int
-	col    int
+	rules Rules
+	row   int
+	col   int
 
 	duration time.Duration
 	start    time.Time
+	pause    time.Time     // start of the current pause
+	paused   time.Duration // total of all finished pauses
+	last     time.Time     // last keystroke
+	idle     time.Duration // total time of keystroke gaps beyond the idle threshold
+	clock    Clock
 	grid     test.Grid
 }
 
 type SessionFactory func() *Session
 
-func NewSession(strict bool, grid test.Grid) *Session {
+// Clock returns the current time, tests control it.
+type Clock func() time.Time
+
+func NewSession(rules Rules, grid test.Grid) *Session {
+	return NewSessionWithClock(rules, grid, time.Now)
+}
+
+func NewSessionWithClock(rules Rules, grid test.Grid, clock Clock) *Session {
 	return &Session{
 		grid:     grid,
-		strict:   strict,
+		rules:    rules,
+		clock:    clock,
 		start:    time.Time{},
+		pause:    time.Time{},
+		paused:   0,
+		last:     time.Time{},
+		idle:     0,
 		duration: 0,
 		row:      0,
 		col:      0,
@@ -38,6 +55,57 @@ func (s *Session) Duration() time.Duration {
 	return s.duration
 }
 
+func (s *Session) Started() bool {
+	return !s.start.IsZero()
+}
+
+func (s *Session) Paused() bool {
+	return !s.pause.IsZero()
+}
+
+// Pause freezes the timer of a running session.
+func (s *Session) Pause() bool {
+	if !s.Started() || s.Done() || s.Paused() {
+		return false
+	}
+
+	s.pause = s.clock()
+
+	return true
+}
+
+func (s *Session) Resume() {
+	if !s.Paused() {
+		return
+	}
+
+	pause := s.clock().Sub(s.pause)
+	s.paused += pause
+	s.last = s.last.Add(pause)
+	s.pause = time.Time{}
+}
+
+// Idle returns the excluded time of keystroke gaps beyond the idle threshold.
+func (s *Session) Idle() time.Duration {
+	return s.idle
+}
+
+// finish stops the timer, the duration excludes all pauses and idle gaps.
+func (s *Session) finish() {
+	s.duration = s.clock().Sub(s.start) - s.paused - s.idle
+}
+
+// touch records a keystroke and sums up the time of gaps beyond the idle threshold.
+func (s *Session) touch() {
+	now := s.clock()
+
+	if gap := now.Sub(s.last); s.rules.Idle > 0 && !s.last.IsZero() && gap > s.rules.Idle {
+		s.idle += gap - s.rules.Idle
+	}
+
+	s.last = now
+}
+
 func (s *Session) Grid() test.Grid {
 	return s.grid
 }
@@ -46,90 +114,258 @@ func (s *Session) Row() int {
 	return s.row
 }
 
-func (s *Session) Advance(r rune) (*test.Cell, bool) {
+func (s *Session) Col() int {
+	return s.col
+}
+
+// Advance types a rune and returns the changed cells in order.
+func (s *Session) Advance(r rune) test.Cells {
 	if s.Done() {
-		return nil, false
+		return nil
 	}
 
 	if s.start.IsZero() {
-		s.start = time.Now()
+		s.start = s.clock()
 	}
 
+	s.touch()
+
 	cell := s.grid[s.row][s.col]
 	if cell == nil {
-		return nil, true
+		return nil
+	}
+
+	if r == ' ' && noBreak(cell.Rune) {
+		r = cell.Rune
+	}
+
+	if s.blocked(r, cell) {
+		return nil
+	}
+
+	if (s.rules.Stop == StopLetter || s.rules.Stop == StopWord && s.lastCell()) && r != cell.Rune {
+		cell.Inputs = append(cell.Inputs, r)
+		cell.Status = test.Failed
+
+		if s.rules.Strict {
+			s.finish()
+		}
+
+		return test.Cells{cell}
 	}
 
-	cell.Inputs = append(cell.Inputs, r)
+	cells := test.Cells{}
 
-	if r == cell.Rune {
+	if s.rules.Words && r == ' ' && cell.Rune != ' ' {
+		cells = s.missWord()
+
+		if s.col == len(s.grid[s.row]) || s.rules.Strict {
+			s.finish()
+
+			return cells
+		}
+
+		cell = s.grid[s.row][s.col]
+	}
+
+	if (s.rules.Extra || s.rules.Words) && cell.Rune == ' ' && r != ' ' {
+		cell.Status = test.Queued
+		cell = test.Extra(r)
+		s.grid[s.row] = slices.Insert(s.grid[s.row], s.col, cell)
+	} else {
+		cell.Inputs = append(cell.Inputs, r)
+	}
+
+	cells = append(cells, cell)
+
+	if r == cell.Rune && !cell.Extra {
 		cell.Status = test.Passed
 	} else {
 		cell.Status = test.Failed
 
-		if s.strict {
-			s.duration = time.Since(s.start)
+		if s.rules.Strict {
+			s.finish()
 
-			return cell, false
+			return cells
 		}
 	}
 
 	s.col++
 	if s.col == len(s.grid[s.row]) || s.grid[s.row][s.col] == nil {
 		if s.row == len(s.grid)-1 {
-			s.duration = time.Since(s.start)
+			s.finish()
 
-			return cell, false
+			return cells
 		}
 
 		s.col = 0
 		s.row++
+	}
 
-		return cell, true
+	return cells
+}
+
+// blocked reports whether a rune is refused: a space at the start of a word in word mode
+// or, if stopping on word errors, leaving an incorrect word, also by finishing the last one.
+func (s *Session) blocked(r rune, cell *test.Cell) bool {
+	if r == ' ' && s.rules.Words && cell.Rune != ' ' && s.atWordStart() {
+		return true
+	}
+
+	if s.rules.Stop != StopWord {
+		return false
+	}
+
+	if s.lastCell() {
+		return s.wordFailed() || r == ' ' && s.rules.Words
+	}
+
+	if r != ' ' {
+		return cell.Rune == ' ' && !s.rules.Extra && !s.rules.Words
 	}
 
-	return cell, false
+	return (s.rules.Words && cell.Rune != ' ') || s.wordFailed()
 }
 
-func (s *Session) RetractRune() (*test.Cell, *test.Cell) {
-	if s.col < 1 {
-		return nil, nil
+// lastCell reports whether the active cell ends the text, no space follows to block a failed word.
+func (s *Session) lastCell() bool {
+	row := s.grid[s.row]
+
+	return s.row == len(s.grid)-1 && (s.col == len(row)-1 || row[s.col+1] == nil)
+}
+
+func (s *Session) atWordStart() bool {
+	return s.col == 0 || boundary(s.grid[s.row][s.col-1])
+}
+
+// wordFailed reports whether the current word contains a failed or extra rune before the active cell.
+func (s *Session) wordFailed() bool {
+	return s.failedBefore(s.row, s.col)
+}
+
+// failedBefore reports whether the word before the position contains an error.
+func (s *Session) failedBefore(row, col int) bool {
+	for c := col - 1; c >= 0 && !boundary(s.grid[row][c]); c-- {
+		if failed(s.grid[row][c]) {
+			return true
+		}
 	}
 
+	return false
+}
+
+// noBreak reports whether the rune is a space that attaches a mark to its word, typed as a regular space.
+func noBreak(r rune) bool {
+	return r == '\u00a0' || r == '\u202f'
+}
+
+// boundary reports whether the cell is a regular space between words.
+func boundary(c *test.Cell) bool {
+	return c.Rune == ' ' && !c.Extra
+}
+
+func failed(c *test.Cell) bool {
+	return c.Extra || c.Status == test.Failed || c.Status == test.Missed
+}
+
+// missWord marks the rest of the current word as missed and stops at the following space.
+func (s *Session) missWord() test.Cells {
+	missed := test.Cells{}
+
+	for ; s.col < len(s.grid[s.row]); s.col++ {
+		cell := s.grid[s.row][s.col]
+		if cell == nil || cell.Rune == ' ' {
+			break
+		}
+
+		cell.Status = test.Missed
+		missed = append(missed, cell)
+	}
+
+	return missed
+}
+
+// RetractRune moves back one rune and returns the new active and the previous cell.
+func (s *Session) RetractRune() test.Cells {
+	row, col, ok := s.prev()
+	if !ok || !s.correctable(row, col) {
+		return nil
+	}
+
+	s.touch()
+
 	curr := s.grid[s.row][s.col]
 	curr.Status = test.Queued
-	s.col--
+
+	s.row = row
+	s.col = col
 
 	prev := s.grid[s.row][s.col]
 	prev.Status = test.Active
+	s.dropExtras()
 
-	return prev, curr
+	return test.Cells{prev, curr}
 }
 
+// RetractWord moves back to the start of the current word, or the previous one at a word start.
 func (s *Session) RetractWord() test.Cells {
-	currs := test.Cells{}
+	cells := s.RetractRune()
+
+	for len(cells) > 0 && !s.atWordStart() {
+		prev := s.RetractRune()
+		if prev == nil {
+			break
+		}
 
-	if s.col < 1 {
-		return currs
+		cells = slices.Insert(cells, 0, prev[0])
 	}
 
-	for s.col > 0 {
-		curr := s.grid[s.row][s.col]
-		curr.Status = test.Queued
-		currs = append(currs, curr)
+	return cells
+}
 
-		s.col--
+func (s *Session) prev() (int, int, bool) {
+	switch {
+	case s.col > 0:
+		return s.row, s.col - 1, true
+	case s.row > 0:
+		return s.row - 1, len(s.grid[s.row-1]) - 1, true
+	default:
+		return 0, 0, false
+	}
+}
 
-		if s.col > 1 && s.grid[s.row][s.col-1].Rune == ' ' {
-			break
-		}
+// correctable reports whether the correction policy allows to move back to the position,
+// stopping on word errors always allows to fix the failed word.
+func (s *Session) correctable(row, col int) bool {
+	cell := s.grid[row][col]
+
+	if s.rules.Stop == StopWord && !boundary(cell) && s.wordFailed() {
+		return true
 	}
 
-	curr := s.grid[s.row][s.col]
-	curr.Status = test.Active
-	currs = append(currs, curr)
+	if s.rules.Confidence {
+		return false
+	}
+
+	switch s.rules.Correction {
+	case CorrectNone:
+		return false
+	case CorrectWord:
+		return !boundary(cell)
+	case CorrectIncorrect:
+		return !boundary(cell) || failed(cell) || s.failedBefore(row, col)
+	default:
+		return true
+	}
+}
 
-	slices.Reverse(currs)
+// dropExtras removes retracted extra cells, the next regular one gets active.
+func (s *Session) dropExtras() {
+	row := s.grid[s.row]
+	tail := slices.DeleteFunc(row[s.col:], func(c *test.Cell) bool {
+		return c.Extra
+	})
 
-	return currs
+	s.grid[s.row] = row[:s.col+len(tail)]
+	s.grid[s.row][s.col].Status = test.Active
 }
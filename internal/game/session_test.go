@@ -0,0 +1,289 @@
+package game_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgf/tygo/internal/game"
+	"github.com/dgf/tygo/internal/test"
+)
+
+func typeRunes(s *game.Session, runes string) {
+	for _, r := range runes {
+		s.Advance(r)
+	}
+}
+
+func statuses(grid test.Grid) string {
+	codes := map[test.Status]rune{
+		test.Queued: '.',
+		test.Failed: 'f',
+		test.Passed: 'p',
+		test.Active: 'a',
+		test.Missed: 'm',
+	}
+
+	result := []rune{}
+
+	for _, row := range grid {
+		for _, cell := range row {
+			if cell.Extra {
+				result = append(result, 'x')
+			} else {
+				result = append(result, codes[cell.Status])
+			}
+		}
+	}
+
+	return string(result)
+}
+
+func TestSession_Advance(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		rules    game.Rules
+		typed    string
+		statuses string
+		done     bool
+	}{
+		{"char", game.Rules{}, "oe two", "pfffff.....", false},
+		{"strict", game.Rules{Strict: true}, "oe two", "pf.........", true},
+		{"extra", game.Rules{Extra: true}, "onee two", "pppxpppp....", false},
+		{"word skip", game.Rules{Words: true}, "o two", "pmmpppp....", false},
+		{"word extra", game.Rules{Words: true}, "onex two", "pppxpppp....", false},
+		{"word start space", game.Rules{Words: true}, "one  two", "ppppppp....", false},
+		{"word skip last", game.Rules{Words: true}, "one two f ", "pppppppppmm", true},
+		{"word skip strict", game.Rules{Strict: true, Words: true}, "o two", "pmm........", true},
+		{"stop letter", game.Rules{Stop: game.StopLetter}, "oxne", "ppp........", false},
+		{"stop letter space", game.Rules{Stop: game.StopLetter, Words: true}, "o ne t", "ppppp......", false},
+		{"stop word", game.Rules{Stop: game.StopWord}, "oxe two", "pfp........", false},
+		{"stop word fixed", game.Rules{Stop: game.StopWord}, "one two", "ppppppp....", false},
+		{"stop word skip", game.Rules{Stop: game.StopWord, Words: true}, "o ne two", "ppppppp....", false},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			session := game.NewSession(testCase.rules, test.ToGrid(20, []string{"one", "two", "foo"}))
+			typeRunes(session, testCase.typed)
+
+			if actual := statuses(session.Grid()); actual != testCase.statuses {
+				t.Errorf("want: %s, got: %s", testCase.statuses, actual)
+			}
+
+			if session.Done() != testCase.done {
+				t.Errorf("want done: %v, got: %v", testCase.done, session.Done())
+			}
+		})
+	}
+}
+
+func TestSession_RetractRuneDropsExtra(t *testing.T) {
+	t.Parallel()
+
+	session := game.NewSession(game.Rules{Extra: true}, test.ToGrid(20, []string{"ab", "cd"}))
+	typeRunes(session, "abxy")
+
+	if actual := statuses(session.Grid()); actual != "ppxx..." {
+		t.Fatalf("invalid setup: %s", actual)
+	}
+
+	session.RetractRune()
+	session.RetractRune()
+
+	if actual := statuses(session.Grid()); actual != "ppa.." {
+		t.Errorf("want: ppa.., got: %s", actual)
+	}
+}
+
+func TestSession_Correction(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name       string
+		correction game.Correction
+		typed      string
+		retracts   int
+		row        int
+		col        int
+	}{
+		{"free", game.CorrectFree, "one tw", 3, 0, 3},
+		{"free across rows", game.CorrectFree, "one two ", 2, 0, 6},
+		{"word", game.CorrectWord, "one tw", 3, 0, 4},
+		{"incorrect passed", game.CorrectIncorrect, "one tw", 3, 0, 4},
+		{"incorrect failed", game.CorrectIncorrect, "oxe tw", 3, 0, 3},
+		{"none", game.CorrectNone, "one tw", 3, 0, 6},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := game.Rules{Correction: testCase.correction}
+			session := game.NewSession(rules, test.ToGrid(8, []string{"one", "two", "foo"}))
+			typeRunes(session, testCase.typed)
+
+			for range testCase.retracts {
+				session.RetractRune()
+			}
+
+			if session.Row() != testCase.row || session.Col() != testCase.col {
+				t.Errorf("want position %d:%d, got: %d:%d",
+					testCase.row, testCase.col, session.Row(), session.Col())
+			}
+		})
+	}
+}
+
+func TestSession_StopWordFixable(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name  string
+		rules game.Rules
+	}{
+		{"correction none", game.Rules{Stop: game.StopWord, Correction: game.CorrectNone}},
+		{"confidence", game.Rules{Stop: game.StopWord, Confidence: true}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			session := game.NewSession(testCase.rules, test.ToGrid(8, []string{"one", "two"}))
+			typeRunes(session, "oxe ")
+
+			if session.Col() != 3 {
+				t.Fatalf("expected space of failed word blocked at 0:3, got: %d:%d", session.Row(), session.Col())
+			}
+
+			if _, ok := game.EventActions(testCase.rules)[test.EventBackRune]; !ok {
+				t.Fatal("expected backspace action to fix the word")
+			}
+
+			session.RetractRune()
+			session.RetractRune()
+			session.RetractRune()
+			typeRunes(session, "ne t")
+
+			if session.Row() != 0 || session.Col() != 5 {
+				t.Errorf("expected next word reached at 0:5, got: %d:%d", session.Row(), session.Col())
+			}
+
+			if cells := session.RetractRune(); cells != nil {
+				t.Errorf("expected no retract out of a correct word, got: %v", cells)
+			}
+		})
+	}
+}
+
+func TestSession_StopWordLastWord(t *testing.T) {
+	t.Parallel()
+
+	session := game.NewSession(game.Rules{Stop: game.StopWord}, test.ToGrid(8, []string{"ab"}))
+	typeRunes(session, "xb")
+
+	if session.Done() || session.Col() != 1 {
+		t.Fatalf("expected the failed last word blocked at 0:1, got: %d:%d", session.Row(), session.Col())
+	}
+
+	session.RetractRune()
+	typeRunes(session, "ax")
+
+	if session.Done() || session.Col() != 1 {
+		t.Fatalf("expected a failed last rune to stay active at 0:1, got: %d:%d", session.Row(), session.Col())
+	}
+
+	typeRunes(session, "b")
+
+	if !session.Done() {
+		t.Error("expected the fixed last word to finish")
+	}
+}
+
+func TestSession_NoBreakSpaceTypedAsSpace(t *testing.T) {
+	t.Parallel()
+
+	session := game.NewSession(game.Rules{}, test.ToGrid(20, []string{"«\u00a0oui\u00a0»", "non\u202f?"}))
+	typeRunes(session, "« oui » non ?")
+
+	if actual := statuses(session.Grid()); actual != "ppppppppppppp" || !session.Done() {
+		t.Errorf("expected all passed and done, got: %s", actual)
+	}
+}
+
+func TestSession_RetractWord(t *testing.T) {
+	t.Parallel()
+
+	session := game.NewSession(game.Rules{}, test.ToGrid(8, []string{"one", "two", "foo"}))
+	typeRunes(session, "one two f")
+
+	for _, want := range []struct{ row, col int }{{1, 0}, {0, 4}, {0, 0}, {0, 0}} {
+		session.RetractWord()
+
+		if session.Row() != want.row || session.Col() != want.col {
+			t.Errorf("want position %d:%d, got: %d:%d", want.row, want.col, session.Row(), session.Col())
+		}
+	}
+}
+
+// fakeClock advances only when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestSession_PauseExcludedFromDuration(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	session := game.NewSessionWithClock(game.Rules{}, test.ToGrid(20, []string{"ab"}), clock.Now)
+
+	if session.Pause() {
+		t.Error("unexpected pause before the start")
+	}
+
+	typeRunes(session, "a")
+
+	if !session.Pause() || !session.Paused() {
+		t.Fatal("expected a paused session")
+	}
+
+	clock.Add(time.Minute)
+	session.Resume()
+	clock.Add(time.Second)
+	typeRunes(session, "b")
+
+	if !session.Done() {
+		t.Fatal("expected a finished session")
+	}
+
+	if session.Duration() != time.Second {
+		t.Errorf("expected duration without pause, got: %s", session.Duration())
+	}
+}
+
+func TestSession_IdleExcludedFromDuration(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	session := game.NewSessionWithClock(game.Rules{Idle: 5 * time.Second}, test.ToGrid(20, []string{"abc"}), clock.Now)
+
+	typeRunes(session, "a")
+	clock.Add(time.Minute)
+	typeRunes(session, "b")
+	clock.Add(time.Second)
+	typeRunes(session, "c")
+
+	if session.Idle() != 55*time.Second {
+		t.Errorf("expected idle time beyond the threshold, got: %s", session.Idle())
+	}
+
+	if session.Duration() != 6*time.Second {
+		t.Errorf("expected duration with the threshold of the idle gap, got: %s", session.Duration())
+	}
+}
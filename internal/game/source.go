@@ -0,0 +1,8 @@
+package game
+
+import "github.com/dgf/tygo/internal/test"
+
+// TextSource produces the text of a session, the same one for the same seed.
+type TextSource interface {
+	Text(seed int64) test.Text
+}
@@ -0,0 +1,126 @@
+package gen
+
+import (
+	"cmp"
+	"maps"
+	"math/rand"
+	"slices"
+)
+
+// maxRejections limits the redraws of blocked values before sampling the others directly.
+const maxRejections = 32
+
+// Alias samples weighted values in constant time with the alias method of Walker and Vose.
+// The table is built of the sorted values with integer thresholds, so a seed draws the same values everywhere.
+type Alias[E cmp.Ordered] struct {
+	values  []E
+	weights []int
+	keep    []int64 // threshold below which a column keeps its value, of sum
+	alias   []int   // the other value of a column
+	sum     int64
+}
+
+// NewAlias builds the table of the weights, at least one must be positive to sample.
+func NewAlias[E cmp.Ordered](dist map[E]int) *Alias[E] {
+	values := slices.Sorted(maps.Keys(dist))
+	n := len(values)
+	a := &Alias[E]{
+		values:  values,
+		weights: make([]int, n),
+		keep:    make([]int64, n),
+		alias:   make([]int, n),
+		sum:     0,
+	}
+
+	for i, v := range values {
+		a.weights[i] = dist[v]
+		a.sum += int64(dist[v])
+	}
+
+	// scaled by the count, the average column is filled with sum
+	scaled := make([]int64, n)
+	small, large := []int{}, []int{}
+
+	for i, w := range a.weights {
+		scaled[i] = int64(w) * int64(n)
+		a.alias[i] = i
+
+		if scaled[i] < a.sum {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+
+		a.keep[s] = scaled[s]
+		a.alias[s] = l
+		scaled[l] -= a.sum - scaled[s]
+
+		if scaled[l] < a.sum {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+
+	for _, i := range append(small, large...) {
+		a.keep[i] = a.sum
+	}
+
+	return a
+}
+
+// Sample draws a value by weight.
+func (a *Alias[E]) Sample(rnd *rand.Rand) E {
+	return a.values[a.index(rnd)]
+}
+
+func (a *Alias[E]) index(rnd *rand.Rand) int {
+	i := rnd.Intn(len(a.values))
+	if rnd.Int63n(a.sum) < a.keep[i] {
+		return i
+	}
+
+	return a.alias[i]
+}
+
+// sampleExcept redraws blocked values, after too many rejections the unblocked ones are sampled directly.
+func (a *Alias[E]) sampleExcept(rnd *rand.Rand, blocked []int) int {
+	for range maxRejections {
+		if i := a.index(rnd); blocked[i] == 0 {
+			return i
+		}
+	}
+
+	var open int64
+
+	for i, w := range a.weights {
+		if blocked[i] == 0 {
+			open += int64(w)
+		}
+	}
+
+	if open == 0 {
+		return a.index(rnd)
+	}
+
+	n := rnd.Int63n(open)
+
+	for i, w := range a.weights {
+		if blocked[i] > 0 {
+			continue
+		}
+
+		if n < int64(w) {
+			return i
+		}
+
+		n -= int64(w)
+	}
+
+	return a.index(rnd) // unreachable, the open weights are drawn above
+}
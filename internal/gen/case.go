@@ -0,0 +1,120 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"unicode"
+)
+
+type LetterCase int
+
+// Letter cases, preserve keeps the case of the words and punctuation.
+// Sentence and random case lower words that are more frequent in lowercase, other capitals like German nouns are kept.
+const (
+	PreserveCase LetterCase = iota
+	LowerCase
+	SentenceCase
+	TitleCase
+	RandomCase
+)
+
+type UnknownCaseError struct {
+	Name string
+}
+
+func (e *UnknownCaseError) Error() string {
+	return fmt.Sprintf("unknown letter case %q, available: preserve, lower, sentence, title, random", e.Name)
+}
+
+func LetterCases() map[string]LetterCase {
+	return map[string]LetterCase{
+		"preserve": PreserveCase,
+		"lower":    LowerCase,
+		"sentence": SentenceCase,
+		"title":    TitleCase,
+		"random":   RandomCase,
+	}
+}
+
+func ParseCase(name string) (LetterCase, error) {
+	c, ok := LetterCases()[strings.ToLower(name)]
+	if !ok {
+		return PreserveCase, &UnknownCaseError{Name: name}
+	}
+
+	return c, nil
+}
+
+// WithCase changes the case of the words, random capitalizes words with the weight as percentage.
+// The lower forms of LowerForms replace capitals of sentence starts in sentence and random case.
+func WithCase(rnd *rand.Rand, words []string, letterCase LetterCase, weight int, lower map[string]string) []string {
+	result := make([]string, len(words))
+
+	for i, word := range words {
+		switch letterCase {
+		case PreserveCase:
+			result[i] = word
+		case LowerCase:
+			result[i] = strings.ToLower(word)
+		case SentenceCase:
+			result[i] = lowerForm(word, lower)
+			if i == 0 || endsSentence(words[i-1]) {
+				result[i] = capitalize(word)
+			}
+		case TitleCase:
+			result[i] = capitalize(word)
+		case RandomCase:
+			result[i] = lowerForm(word, lower)
+			if rnd.Intn(100) < weight {
+				result[i] = capitalize(word)
+			}
+		}
+	}
+
+	return result
+}
+
+// LowerForms maps the capitalized words of a ranked list to their lowercase form, if that one ranks higher.
+// So "The" becomes "the", but "I" or German nouns without a more frequent lowercase form are kept.
+func LowerForms(ranked []string) map[string]string {
+	ranks := make(map[string]int, len(ranked))
+	for i, word := range ranked {
+		if _, ok := ranks[word]; !ok {
+			ranks[word] = i
+		}
+	}
+
+	forms := map[string]string{}
+
+	for word, rank := range ranks {
+		lower := strings.ToLower(word)
+		if r, ok := ranks[lower]; ok && lower != word && r < rank {
+			forms[word] = lower
+		}
+	}
+
+	return forms
+}
+
+// lowerForm replaces the letters of the word with their lower form, marks around are kept.
+func lowerForm(word string, lower map[string]string) string {
+	letters := strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	if form, ok := lower[letters]; ok {
+		return strings.Replace(word, letters, form, 1)
+	}
+
+	return word
+}
+
+// endsSentence reports whether the word ends with a sentence mark, closing quotes or brackets may follow.
+func endsSentence(word string) bool {
+	word = strings.TrimRightFunc(word, func(r rune) bool {
+		return unicode.In(r, unicode.Pe, unicode.Pf, unicode.Pi) || unicode.IsSpace(r) || r == '"'
+	})
+
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "?") || strings.HasSuffix(word, "!")
+}
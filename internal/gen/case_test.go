@@ -0,0 +1,127 @@
+package gen_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+func TestWithCase(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"The", "cat", "sat.", "In", "«the»", "hat?", "(Yes!)", "ok"}
+
+	for _, testCase := range []struct {
+		name     string
+		mode     gen.LetterCase
+		expected []string
+	}{
+		{"preserve", gen.PreserveCase, words},
+		{"lower", gen.LowerCase, []string{"the", "cat", "sat.", "in", "«the»", "hat?", "(yes!)", "ok"}},
+		{"sentence", gen.SentenceCase, []string{"The", "cat", "sat.", "In", "«the»", "hat?", "(Yes!)", "Ok"}},
+		{"title", gen.TitleCase, []string{"The", "Cat", "Sat.", "In", "«The»", "Hat?", "(Yes!)", "Ok"}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := gen.WithCase(gen.NewRand(1), words, testCase.mode, 0, nil)
+
+			if !slices.Equal(testCase.expected, actual) {
+				t.Errorf("expected %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithCase_KeepsCapitals(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		mode     gen.LetterCase
+		words    []string
+		expected []string
+	}{
+		{
+			"sentence german", gen.SentenceCase,
+			[]string{"der", "Hund", "bellt.", "die", "Katze", "schläft"},
+			[]string{"Der", "Hund", "bellt.", "Die", "Katze", "schläft"},
+		},
+		{"sentence english", gen.SentenceCase, []string{"yes", "I", "can."}, []string{"Yes", "I", "can."}},
+		{
+			"random german", gen.RandomCase,
+			[]string{"der", "Hund", "bellt.", "Die", "Katze", "schläft"},
+			[]string{"der", "Hund", "bellt.", "Die", "Katze", "schläft"},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := gen.WithCase(gen.NewRand(1), testCase.words, testCase.mode, 0, nil)
+
+			if !slices.Equal(testCase.expected, actual) {
+				t.Errorf("expected %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithCase_LowerForms(t *testing.T) {
+	t.Parallel()
+
+	lower := gen.LowerForms([]string{"the", "in", "The", "I", "Haus", "In", "i"})
+	words := []string{"The", "cat", "in", "«The»", "hat.", "In", "I", "see", "Haus."}
+
+	for _, testCase := range []struct {
+		name     string
+		mode     gen.LetterCase
+		expected []string
+	}{
+		{"sentence", gen.SentenceCase, []string{"The", "cat", "in", "«the»", "hat.", "In", "I", "see", "Haus."}},
+		{"random", gen.RandomCase, []string{"the", "cat", "in", "«the»", "hat.", "in", "I", "see", "Haus."}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := gen.WithCase(gen.NewRand(1), words, testCase.mode, 0, lower)
+
+			if !slices.Equal(testCase.expected, actual) {
+				t.Errorf("expected %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithCase_RandomWeight(t *testing.T) {
+	t.Parallel()
+
+	words := slices.Repeat([]string{"word"}, 1000)
+
+	for _, weight := range []int{0, 30, 100} {
+		capitals := 0
+
+		for _, word := range gen.WithCase(gen.NewRand(1), words, gen.RandomCase, weight, nil) {
+			if word != strings.ToLower(word) {
+				capitals++
+			}
+		}
+
+		if capitals < len(words)*weight/100-50 || capitals > len(words)*weight/100+50 {
+			t.Errorf("expected about %d%% capitalized words, got: %d of %d", weight, capitals, len(words))
+		}
+	}
+}
+
+func TestParseCase(t *testing.T) {
+	t.Parallel()
+
+	if c, err := gen.ParseCase("Title"); err != nil || c != gen.TitleCase {
+		t.Errorf("expected title case, got: %v, %v", c, err)
+	}
+
+	if _, err := gen.ParseCase("upper"); err == nil {
+		t.Error("expected unknown case error")
+	}
+}
@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Generator names.
+const (
+	WeightedGenerator = "weighted"
+	MarkovGenerator   = "markov"
+	PseudoGenerator   = "pseudo"
+)
+
+// Generator produces the words of a test.
+type Generator interface {
+	Generate(rnd *rand.Rand, count int) []string
+}
+
+type UnknownGeneratorError struct {
+	Name string
+}
+
+func (e *UnknownGeneratorError) Error() string {
+	return fmt.Sprintf("unknown generator %q", e.Name)
+}
+
+// Weighted samples the words by their rank, the first word is the most frequent.
+type Weighted struct {
+	Words    []string
+	NoRepeat int // window of recent words that aren't repeated
+}
+
+func (w Weighted) Generate(rnd *rand.Rand, count int) []string {
+	return SampleWeightedList(rnd, count, w.NoRepeat, w.Words)
+}
@@ -0,0 +1,168 @@
+package gen
+
+import "fmt"
+
+// Spaces that attach marks to their word, a typed space matches them.
+const (
+	NoBreakSpace       = "\u00a0"
+	NarrowNoBreakSpace = "\u202f"
+)
+
+// Language holds the punctuation rules of a written language.
+type Language struct {
+	Quotes       [2]string         // opening and closing quotation marks
+	Spaced       bool              // no-break space before ? ! : ; and inside quotes, like in French
+	Inverted     bool              // questions and exclamations open with ¿ and ¡, like in Spanish
+	Contractions map[string]string // lowercase words and their contraction
+	Locale       Locale
+}
+
+// Locale holds the number formats of a language.
+type Locale struct {
+	Decimal   string    // decimal separator
+	Thousands string    // digit group separator
+	Date      string    // layout of dates, see time.Layout
+	Time      string    // layout of times of day
+	Currency  [2]string // prefix and suffix of amounts
+	Percent   string    // suffix of percentages
+	Phone     string    // pattern of phone numbers, # is a digit
+}
+
+type UnknownLanguageError struct {
+	Name string
+}
+
+func (e *UnknownLanguageError) Error() string {
+	return fmt.Sprintf("unknown language %q", e.Name)
+}
+
+func Languages() map[string]Language {
+	return map[string]Language{
+		"english": {
+			Quotes:       [2]string{"\"", "\""},
+			Spaced:       false,
+			Inverted:     false,
+			Contractions: EnglishContractions(),
+			Locale: Locale{
+				Decimal:   ".",
+				Thousands: ",",
+				Date:      "01/02/2006",
+				Time:      "3:04pm",
+				Currency:  [2]string{"$", ""},
+				Percent:   "%",
+				Phone:     "###-###-####",
+			},
+		},
+		"german": {
+			Quotes:       [2]string{"„", "“"},
+			Spaced:       false,
+			Inverted:     false,
+			Contractions: GermanContractions(),
+			Locale: Locale{
+				Decimal:   ",",
+				Thousands: ".",
+				Date:      "02.01.2006",
+				Time:      "15:04",
+				Currency:  [2]string{"", "€"},
+				Percent:   "%",
+				Phone:     "0###/#######",
+			},
+		},
+		"french": {
+			Quotes:       [2]string{"«", "»"},
+			Spaced:       true,
+			Inverted:     false,
+			Contractions: nil,
+			Locale: Locale{
+				Decimal:   ",",
+				Thousands: "",
+				Date:      "02/01/2006",
+				Time:      "15h04",
+				Currency:  [2]string{"", "€"},
+				Percent:   "%",
+				Phone:     "0#.##.##.##.##",
+			},
+		},
+		"spanish": {
+			Quotes:       [2]string{"«", "»"},
+			Spaced:       false,
+			Inverted:     true,
+			Contractions: nil,
+			Locale: Locale{
+				Decimal:   ",",
+				Thousands: ".",
+				Date:      "02/01/2006",
+				Time:      "15:04",
+				Currency:  [2]string{"", "€"},
+				Percent:   "%",
+				Phone:     "###-###-###",
+			},
+		},
+	}
+}
+
+// Frames returns the opening and closing marks of the enframing punctuation.
+func (l Language) Frames() map[Punctuation][2]string {
+	space := ""
+	if l.Spaced {
+		space = NoBreakSpace
+	}
+
+	return map[Punctuation][2]string{
+		Quotation:   {l.Quotes[0] + space, space + l.Quotes[1]},
+		Brackets:    {"[", "]"},
+		Braces:      {"{", "}"},
+		Parenthesis: {"(", ")"},
+	}
+}
+
+func LoadLanguage(name string) (Language, error) {
+	lang, ok := Languages()[name]
+	if !ok {
+		return lang, &UnknownLanguageError{Name: name}
+	}
+
+	return lang, nil
+}
+
+func EnglishContractions() map[string]string {
+	return map[string]string{
+		"are":    "aren't",
+		"can":    "can't",
+		"could":  "couldn't",
+		"did":    "didn't",
+		"do":     "don't",
+		"does":   "doesn't",
+		"has":    "hasn't",
+		"have":   "haven't",
+		"he":     "he's",
+		"i":      "I'm", // the pronoun is capitalized anywhere
+		"is":     "isn't",
+		"it":     "it's",
+		"let":    "let's",
+		"she":    "she's",
+		"should": "shouldn't",
+		"that":   "that's",
+		"there":  "there's",
+		"they":   "they're",
+		"was":    "wasn't",
+		"we":     "we're",
+		"were":   "weren't",
+		"what":   "what's",
+		"will":   "won't",
+		"would":  "wouldn't",
+		"you":    "you're",
+	}
+}
+
+func GermanContractions() map[string]string {
+	return map[string]string{
+		"geht": "geht's",
+		"gibt": "gibt's",
+		"hat":  "hat's",
+		"ist":  "ist's",
+		"war":  "war's",
+		"wie":  "wie's",
+		"wird": "wird's",
+	}
+}
@@ -0,0 +1,118 @@
+package gen
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MarkovOrder is the count of preceding words that determine the next one,
+// a higher order mostly repeats the sentences of small corpora.
+const MarkovOrder = 1
+
+type ShortCorpusError struct {
+	Words int
+	Order int
+}
+
+func (e *ShortCorpusError) Error() string {
+	return fmt.Sprintf("corpus of %d words is too short for a chain of order %d", e.Words, e.Order)
+}
+
+// Markov generates word sequences with the transitions of a trained text.
+type Markov struct {
+	order  int
+	starts []string            // states that start a sentence
+	states []string            // all states with followers, sorted
+	chain  map[string][]string // state of preceding words to the following words
+}
+
+// NewMarkov trains a chain of the given order on the words of a text, punctuation is dropped.
+func NewMarkov(order int, text string) (*Markov, error) {
+	m := &Markov{order: max(1, order), starts: nil, states: nil, chain: map[string][]string{}}
+	words, starts := corpusWords(text)
+	startSet := map[string]bool{}
+
+	for i := 0; i+m.order < len(words); i++ {
+		state := strings.Join(words[i:i+m.order], " ")
+		m.chain[state] = append(m.chain[state], words[i+m.order])
+
+		if starts[i] {
+			startSet[state] = true
+		}
+	}
+
+	if len(m.chain) == 0 {
+		return nil, &ShortCorpusError{Words: len(words), Order: m.order}
+	}
+
+	m.states = slices.Sorted(maps.Keys(m.chain))
+	m.starts = slices.Sorted(maps.Keys(startSet))
+
+	if len(m.starts) == 0 {
+		m.starts = m.states
+	}
+
+	return m, nil
+}
+
+// Generate walks the chain and restarts at a sentence start on dead ends.
+func (m *Markov) Generate(rnd *rand.Rand, count int) []string {
+	result := make([]string, 0, count+m.order)
+
+	for len(result) < count {
+		state := m.starts[rnd.Intn(len(m.starts))]
+		result = append(result, strings.Fields(state)...)
+
+		for len(result) < count {
+			followers, ok := m.chain[state]
+			if !ok {
+				break
+			}
+
+			next := followers[rnd.Intn(len(followers))]
+			result = append(result, next)
+			state = strings.Join(result[len(result)-m.order:], " ")
+		}
+	}
+
+	return result[:count]
+}
+
+// corpusWords splits the text into words without punctuation and flags the sentence starts,
+// capitals of sentence starts are dropped if the word occurs in lowercase too.
+func corpusWords(text string) ([]string, []bool) {
+	words := []string{}
+	starts := []bool{}
+	lower := map[string]bool{}
+	sentenceStart := true
+
+	for _, token := range strings.Fields(text) {
+		word := strings.TrimFunc(token, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		if len(word) == 0 {
+			continue
+		}
+
+		if !sentenceStart && word == strings.ToLower(word) {
+			lower[word] = true
+		}
+
+		words = append(words, word)
+		starts = append(starts, sentenceStart)
+		sentenceStart = strings.ContainsAny(token, ".?!")
+	}
+
+	for i, word := range words {
+		if starts[i] && lower[strings.ToLower(word)] {
+			words[i] = strings.ToLower(word)
+		}
+	}
+
+	return words, starts
+}
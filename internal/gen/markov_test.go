@@ -0,0 +1,65 @@
+package gen_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+const corpus = "The cat sat on the mat. The dog sat on the cat! A bird flew over the dog, and the cat ran."
+
+func TestMarkov_Generate(t *testing.T) {
+	t.Parallel()
+
+	markov := newMarkov(t)
+	known := strings.Fields("the cat sat on mat dog A bird flew over and ran")
+
+	for _, count := range []int{1, 5, 50} {
+		words := markov.Generate(gen.NewRand(1), count)
+
+		if len(words) != count {
+			t.Errorf("expected %d words, got: %d", count, len(words))
+		}
+
+		for _, word := range words {
+			if !slices.Contains(known, word) {
+				t.Errorf("expected words of the corpus, got: %q", word)
+			}
+		}
+	}
+}
+
+func TestMarkov_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	markov := newMarkov(t)
+	first := markov.Generate(gen.NewRand(42), 30)
+	second := markov.Generate(gen.NewRand(42), 30)
+
+	if !slices.Equal(first, second) {
+		t.Errorf("expected same words, got: %q and %q", first, second)
+	}
+}
+
+func TestMarkov_ShortCorpus(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"", "one", "too short"} {
+		if _, err := gen.NewMarkov(len(strings.Fields(text)), text); err == nil {
+			t.Errorf("expected short corpus error of %q", text)
+		}
+	}
+}
+
+func newMarkov(t *testing.T) *gen.Markov {
+	t.Helper()
+
+	markov, err := gen.NewMarkov(gen.MarkovOrder, corpus)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return markov
+}
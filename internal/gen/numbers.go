@@ -1,22 +1,139 @@
 package gen
 
 import (
+	"fmt"
+	"math/rand"
 	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const MaxRandomNumber = 9999
 
-func WithNumbers(weight int, words []string) []string {
+type NumberFormat int
+
+const (
+	Integer NumberFormat = iota
+	Decimal
+	Percent
+	Date
+	Time
+	Currency
+	Phone
+	SemVer
+	Range
+)
+
+// Limits of the generated numbers.
+const (
+	maxAmountCents = 1_000_000
+	maxDecimal     = 1000
+	maxPercent     = 100
+	maxRange       = 100
+	minYear        = 1950
+	yearSpan       = 100
+	daysPerYear    = 365
+	hoursPerDay    = 24
+	minutesPerHour = 60
+	maxMajor       = 10
+	maxMinor       = 20
+	maxPatch       = 50
+	centsPerUnit   = 100
+	digitGroup     = 3
+	digits         = 10
+)
+
+// NumberFormatter returns a random number of one format.
+type NumberFormatter func(rnd *rand.Rand) string
+
+// WithNumbers replaces words with numbers of the weighted formats, the weight is a percentage.
+func WithNumbers(rnd *rand.Rand, weight int, words []string, formats map[NumberFormat]int, loc Locale) []string {
 	result := slices.Clone(words)
-	count := len(words)
-	dist := map[bool]int{false: 100 - weight, true: weight}
+	formatters := NumberFormatters(loc)
+
+	if sum(formats) == 0 {
+		formats = map[NumberFormat]int{Integer: 1}
+	}
+
+	sampler := NewAlias(formats)
 
-	for i, b := range SampleWeightedDist(count, dist) {
-		if b {
-			result[i] = strconv.Itoa(randGen.Intn(MaxRandomNumber) + 1)
+	for i := range result {
+		if rnd.Intn(100) < weight {
+			result[i] = formatters[sampler.Sample(rnd)](rnd)
 		}
 	}
 
 	return result
 }
+
+func NumberFormatters(loc Locale) map[NumberFormat]NumberFormatter {
+	return map[NumberFormat]NumberFormatter{
+		Integer: func(rnd *rand.Rand) string {
+			return strconv.Itoa(rnd.Intn(MaxRandomNumber) + 1)
+		},
+		Decimal: func(rnd *rand.Rand) string {
+			return strconv.Itoa(rnd.Intn(maxDecimal)) + loc.Decimal + fmt.Sprintf("%02d", rnd.Intn(centsPerUnit))
+		},
+		Percent: func(rnd *rand.Rand) string {
+			return strconv.Itoa(rnd.Intn(maxPercent+1)) + loc.Percent
+		},
+		Date: func(rnd *rand.Rand) string {
+			day := time.Date(minYear+rnd.Intn(yearSpan), time.January, 1+rnd.Intn(daysPerYear), 0, 0, 0, 0, time.UTC)
+
+			return day.Format(loc.Date)
+		},
+		Time: func(rnd *rand.Rand) string {
+			return time.Date(0, 1, 1, rnd.Intn(hoursPerDay), rnd.Intn(minutesPerHour), 0, 0, time.UTC).Format(loc.Time)
+		},
+		Currency: func(rnd *rand.Rand) string {
+			cents := rnd.Intn(maxAmountCents)
+			amount := group(cents/centsPerUnit, loc.Thousands) + loc.Decimal + fmt.Sprintf("%02d", cents%centsPerUnit)
+
+			return loc.Currency[0] + amount + loc.Currency[1]
+		},
+		Phone: func(rnd *rand.Rand) string {
+			return strings.Map(func(r rune) rune {
+				if r == '#' {
+					return rune('0' + rnd.Intn(digits))
+				}
+
+				return r
+			}, loc.Phone)
+		},
+		SemVer: func(rnd *rand.Rand) string {
+			return fmt.Sprintf("%d.%d.%d", rnd.Intn(maxMajor), rnd.Intn(maxMinor), rnd.Intn(maxPatch))
+		},
+		Range: func(rnd *rand.Rand) string {
+			from := rnd.Intn(maxRange)
+
+			return fmt.Sprintf("%d-%d", from, from+1+rnd.Intn(maxRange))
+		},
+	}
+}
+
+// group separates the digits of the number in groups of three.
+func group(n int, separator string) string {
+	digits := strconv.Itoa(n)
+
+	var b strings.Builder
+
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%digitGroup == 0 {
+			b.WriteString(separator)
+		}
+
+		b.WriteRune(d)
+	}
+
+	return b.String()
+}
+
+func sum[E comparable](weights map[E]int) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+
+	return total
+}
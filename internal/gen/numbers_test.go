@@ -0,0 +1,66 @@
+package gen_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+func TestNumberFormatters(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		lang     string
+		format   gen.NumberFormat
+		expected string
+	}{
+		{"integer english", "english", gen.Integer, `^\d{1,4}$`},
+		{"decimal english", "english", gen.Decimal, `^\d{1,3}\.\d\d$`},
+		{"decimal german", "german", gen.Decimal, `^\d{1,3},\d\d$`},
+		{"percent english", "english", gen.Percent, `^\d{1,3}%$`},
+		{"date english", "english", gen.Date, `^\d\d/\d\d/\d{4}$`},
+		{"date german", "german", gen.Date, `^\d\d\.\d\d\.\d{4}$`},
+		{"time english", "english", gen.Time, `^\d{1,2}:\d\d[ap]m$`},
+		{"time french", "french", gen.Time, `^\d\dh\d\d$`},
+		{"currency english", "english", gen.Currency, `^\$\d{1,3}(,\d{3})*\.\d\d$`},
+		{"currency german", "german", gen.Currency, `^\d{1,3}(\.\d{3})*,\d\d€$`},
+		{"phone english", "english", gen.Phone, `^\d{3}-\d{3}-\d{4}$`},
+		{"semver english", "english", gen.SemVer, `^\d\.\d{1,2}\.\d{1,2}$`},
+		{"range english", "english", gen.Range, `^\d{1,2}-\d{1,3}$`},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			expected := regexp.MustCompile(testCase.expected)
+			format := gen.NumberFormatters(gen.Languages()[testCase.lang].Locale)[testCase.format]
+			rnd := gen.NewRand(1)
+
+			for range 100 {
+				if number := format(rnd); !expected.MatchString(number) {
+					t.Fatalf("expected format %d to match %s, got: %q", testCase.format, testCase.expected, number)
+				}
+			}
+		})
+	}
+}
+
+func TestWithNumbers(t *testing.T) {
+	t.Parallel()
+
+	words := make([]string, 1000)
+	list := gen.WithNumbers(gen.NewRand(1), 50, words, map[gen.NumberFormat]int{gen.Percent: 1}, gen.Locale{})
+
+	replaced := 0
+
+	for _, w := range list {
+		if len(w) > 0 {
+			replaced++
+		}
+	}
+
+	if replaced < 400 || replaced > 600 {
+		t.Errorf("expected about half of the words replaced, got: %d", replaced)
+	}
+}
@@ -0,0 +1,171 @@
+package gen
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// Length limits of pseudo-words in letters.
+const (
+	MinPseudoLetters = 2
+	MaxPseudoLetters = 10
+	pseudoAttempts   = 20
+)
+
+// boundary pads the start and marks the end of a word in the trigrams.
+const boundary = ' '
+
+// Pseudo generates pronounceable words with the letter trigrams of a dictionary.
+type Pseudo struct {
+	trigrams  map[string]map[rune]int // two preceding letters to the counts of the next one
+	followers map[follow]*Alias[rune] // samplers of the allowed next letters, built once
+	words     map[string]bool         // real words to avoid
+	charset   []rune                  // sorted letters to use, all if empty
+	alphabet  []rune                  // sorted letters of the fallback
+}
+
+// follow keys the sampler of the next letter by the two preceding letters and the length limit of the word.
+type follow struct {
+	state  string
+	length int
+}
+
+// NewPseudo counts the trigrams of the lowercased words, the charset constrains the generated letters.
+func NewPseudo(words []string, charset string) *Pseudo {
+	p := &Pseudo{
+		trigrams:  map[string]map[rune]int{},
+		followers: map[follow]*Alias[rune]{},
+		words:     map[string]bool{},
+		charset:   nil,
+		alphabet:  nil,
+	}
+
+	for _, r := range strings.ToLower(charset) {
+		if r != boundary && !slices.Contains(p.charset, r) {
+			p.charset = append(p.charset, r)
+		}
+	}
+
+	slices.Sort(p.charset)
+
+	for _, word := range words {
+		word = strings.ToLower(word)
+		p.words[word] = true
+		letters := []rune(string([]rune{boundary, boundary}) + word + string(boundary))
+
+		for i := 2; i < len(letters); i++ {
+			state := string(letters[i-2 : i])
+			if p.trigrams[state] == nil {
+				p.trigrams[state] = map[rune]int{}
+			}
+
+			p.trigrams[state][letters[i]]++
+
+			if letters[i] != boundary && !slices.Contains(p.alphabet, letters[i]) {
+				p.alphabet = append(p.alphabet, letters[i])
+			}
+		}
+	}
+
+	slices.Sort(p.alphabet)
+
+	if len(p.charset) > 0 {
+		p.alphabet = p.charset
+	}
+
+	for state := range p.trigrams {
+		for _, length := range []int{0, MinPseudoLetters, MaxPseudoLetters} {
+			if followers := p.allowedFollowers(state, length); len(followers) > 0 {
+				p.followers[follow{state: state, length: length}] = NewAlias(followers)
+			}
+		}
+	}
+
+	return p
+}
+
+// Generate returns pseudo-words, real words of the dictionary are avoided.
+func (p *Pseudo) Generate(rnd *rand.Rand, count int) []string {
+	if len(p.trigrams) == 0 {
+		return nil
+	}
+
+	result := make([]string, count)
+
+	for i := range result {
+		result[i] = p.word(rnd)
+	}
+
+	return result
+}
+
+func (p *Pseudo) word(rnd *rand.Rand) string {
+	for range pseudoAttempts {
+		if word, ok := p.build(rnd); ok && !p.words[word] {
+			return word
+		}
+	}
+
+	return p.fallback(rnd)
+}
+
+// build walks the trigrams until the word ends, it fails on letters without allowed successors.
+func (p *Pseudo) build(rnd *rand.Rand) (string, bool) {
+	letters := []rune{boundary, boundary}
+
+	for {
+		followers, ok := p.followers[follow{state: string(letters[len(letters)-2:]), length: limit(len(letters) - 2)}]
+		if !ok {
+			return "", false
+		}
+
+		next := followers.Sample(rnd)
+		if next == boundary {
+			return string(letters[2:]), true
+		}
+
+		letters = append(letters, next)
+	}
+}
+
+// allowedFollowers counts the next letters of the state allowed at the length of the word.
+func (p *Pseudo) allowedFollowers(state string, length int) map[rune]int {
+	followers := map[rune]int{}
+
+	for next, n := range p.trigrams[state] {
+		if next == boundary && length >= MinPseudoLetters ||
+			next != boundary && length < MaxPseudoLetters && p.allowed(next) {
+			followers[next] = n
+		}
+	}
+
+	return followers
+}
+
+// limit maps the length to the first one of its range, the allowed next letters only change at the limits.
+func limit(length int) int {
+	switch {
+	case length < MinPseudoLetters:
+		return 0
+	case length < MaxPseudoLetters:
+		return MinPseudoLetters
+	default:
+		return MaxPseudoLetters
+	}
+}
+
+func (p *Pseudo) allowed(r rune) bool {
+	return len(p.charset) == 0 || slices.Contains(p.charset, r)
+}
+
+// fallback joins random letters, if the trigrams can't build a new word of the charset.
+func (p *Pseudo) fallback(rnd *rand.Rand) string {
+	var b strings.Builder
+
+	for range MinPseudoLetters + rnd.Intn(MaxPseudoLetters-MinPseudoLetters+1) {
+		b.WriteRune(p.alphabet[rnd.Intn(len(p.alphabet))])
+	}
+
+	return b.String()
+}
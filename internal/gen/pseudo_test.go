@@ -0,0 +1,66 @@
+package gen_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+var pseudoWords = []string{
+	"there", "these", "other", "thing", "think", "those", "three", "throw", "three", "where", "while",
+}
+
+func TestPseudo_Generate(t *testing.T) {
+	t.Parallel()
+
+	words := gen.NewPseudo(pseudoWords, "").Generate(gen.NewRand(1), 50)
+
+	if len(words) != 50 {
+		t.Fatalf("expected 50 words, got: %d", len(words))
+	}
+
+	for _, word := range words {
+		if n := len([]rune(word)); n < gen.MinPseudoLetters || n > gen.MaxPseudoLetters {
+			t.Errorf("expected length of pseudo-word %q in limits, got: %d", word, n)
+		}
+
+		if slices.Contains(pseudoWords, word) {
+			t.Errorf("expected no real word, got: %q", word)
+		}
+	}
+}
+
+func TestPseudo_Charset(t *testing.T) {
+	t.Parallel()
+
+	for _, charset := range []string{"theswr", "ot", "xyz"} {
+		for _, word := range gen.NewPseudo(pseudoWords, charset).Generate(gen.NewRand(1), 30) {
+			if len(word) == 0 || strings.Trim(word, charset) != "" {
+				t.Errorf("expected letters of %q, got: %q", charset, word)
+			}
+		}
+	}
+}
+
+func TestPseudo_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	pseudo := gen.NewPseudo(pseudoWords, "")
+	first := pseudo.Generate(gen.NewRand(42), 20)
+	second := pseudo.Generate(gen.NewRand(42), 20)
+
+	if !slices.Equal(first, second) {
+		t.Errorf("expected same words, got: %q and %q", first, second)
+	}
+}
+
+func BenchmarkPseudo_Generate(b *testing.B) {
+	pseudo := gen.NewPseudo(pseudoWords, "")
+	rnd := gen.NewRand(1)
+
+	for b.Loop() {
+		pseudo.Generate(rnd, 100)
+	}
+}
@@ -1,7 +1,9 @@
 package gen
 
 import (
+	"math/rand"
 	"slices"
+	"strings"
 	"unicode"
 )
 
@@ -19,41 +21,114 @@ const (
 	Parenthesis
 	Colon
 	Semicolon
+	Apostrophe
+	Dash
+	Hyphen
+	Ellipsis
+	SnakeCase
+	CamelCase
+	Call
+	Arrow
+	NotEqual
+	LogicalAnd
+	LogicalOr
+	Include
+	Variable
+	Tag
+	Index
+	Assign
 )
 
-// Apostrophe
-// Dash
-// Hyphen
-// Ellipsis
+const (
+	dashMark   = "–"
+	hyphenMark = "-"
+	joinMark   = "\u2060" // joins the word with the next one
+	camelMark  = "\u2061" // joins the word with the next one capitalized
+)
 
-func PunctuationMarks(words []string, dist map[Punctuation]int) []string {
+// PunctuationMarks applies random marks by the rules of the language and closes the last sentence.
+func PunctuationMarks(rnd *rand.Rand, words []string, dist map[Punctuation]int, lang Language) []string {
 	result := slices.Clone(words)
+	applicators := PunctuationApplicators(lang)
 
-	// Uppercase first word
-	first := []rune(result[0])
-	first[0] = unicode.ToTitle(first[0])
-	result[0] = string(first)
-
-	// last closed
-	lastPunct := SampleWeightedDist(1, map[Punctuation]int{
+	// last closed, sampled first
+	lastPunct := SampleWeightedDist(rnd, 1, map[Punctuation]int{
 		Period:      dist[Period],
 		Question:    dist[Question],
 		Exclamation: dist[Exclamation],
 	})
-	result[len(result)-1] = applyPunctuation(lastPunct[0], result[len(result)-1])
 
-	// apply random to all between
-	for p, punct := range SampleWeightedDist(len(words)-2, dist) {
-		result[p+1] = applyPunctuation(punct, result[p+1])
+	// random to all between
+	puncts := append(SampleWeightedDist(rnd, len(words)-2, dist), lastPunct[0])
+
+	// Uppercase first word
+	result[0] = capitalize(result[0])
+	start := 0
 
-		if slices.Contains([]Punctuation{Period, Question, Exclamation}, punct) {
-			r := []rune(result[p+2])
-			r[0] = unicode.ToTitle(r[0])
-			result[p+2] = string(r)
+	for p, punct := range puncts {
+		// no frame around the second part of a compound
+		if joined(result[p]) && slices.Contains(enframing(), punct) {
+			punct = Word
 		}
+
+		result[p+1] = applyPunctuation(applicators, punct, result[p+1])
+
+		if !slices.Contains([]Punctuation{Period, Question, Exclamation}, punct) {
+			continue
+		}
+
+		if lang.Inverted {
+			result[start] = invertedMarks()[punct] + result[start]
+		}
+
+		if start = p + 2; start < len(result) {
+			result[start] = capitalize(result[start])
+		}
+	}
+
+	return compose(result)
+}
+
+func invertedMarks() map[Punctuation]string {
+	return map[Punctuation]string{
+		Question:    "¿",
+		Exclamation: "¡",
+	}
+}
+
+func enframing() []Punctuation {
+	return []Punctuation{Quotation, Brackets, Braces, Parenthesis}
+}
+
+// joined reports whether the word is marked to join the following one.
+func joined(word string) bool {
+	return strings.HasSuffix(word, joinMark) || strings.HasSuffix(word, camelMark)
+}
+
+// compose joins marked words, e.g. compounds, and separates dashes.
+func compose(words []string) []string {
+	parts := strings.Split(strings.Join(words, " "), camelMark+" ")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = capitalize(parts[i])
 	}
 
-	return result
+	text := strings.ReplaceAll(strings.Join(parts, ""), joinMark+" ", "")
+	text = strings.NewReplacer(joinMark, "", camelMark, "").Replace(text)
+
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == ' ' // no-break spaces keep marks attached
+	})
+}
+
+// capitalize titles the first letter only, other capitals like German nouns are kept.
+func capitalize(word string) string {
+	r := []rune(word)
+
+	if i := slices.IndexFunc(r, unicode.IsLetter); i >= 0 {
+		r[i] = unicode.ToTitle(r[i])
+	}
+
+	return string(r)
 }
 
 type Applicator func(word string) string
@@ -68,30 +143,55 @@ func Echo(word string) string {
 	return word
 }
 
+// Contract shortens common words, e.g. do to don't, others are kept.
+func Contract(contractions map[string]string) Applicator {
+	return func(word string) string {
+		contraction, ok := contractions[strings.ToLower(word)]
+
+		switch {
+		case !ok:
+			return word
+		case unicode.IsUpper([]rune(word)[0]):
+			return capitalize(contraction)
+		default:
+			return contraction
+		}
+	}
+}
+
 func Enframe(prefix, suffix string) Applicator {
 	return func(word string) string {
 		return prefix + word + suffix
 	}
 }
 
-func PunctuationApplicators() map[Punctuation]Applicator {
+func PunctuationApplicators(lang Language) map[Punctuation]Applicator {
+	space := ""
+	if lang.Spaced {
+		space = NarrowNoBreakSpace
+	}
+
 	return map[Punctuation]Applicator{
 		Word:        Echo,
 		Period:      Append("."),
 		Comma:       Append(","),
-		Quotation:   Enframe("\"", "\""),
-		Question:    Append("?"),
-		Exclamation: Append("!"),
+		Quotation:   Enframe(lang.Frames()[Quotation][0], lang.Frames()[Quotation][1]),
+		Question:    Append(space + "?"),
+		Exclamation: Append(space + "!"),
 		Brackets:    Enframe("[", "]"),
 		Braces:      Enframe("{", "}"),
 		Parenthesis: Enframe("(", ")"),
-		Colon:       Append(":"),
-		Semicolon:   Append(";"),
+		Colon:       Append(space + ":"),
+		Semicolon:   Append(space + ";"),
+		Apostrophe:  Contract(lang.Contractions),
+		Dash:        Append(" " + dashMark),
+		Hyphen:      Append(hyphenMark + joinMark),
+		Ellipsis:    Append("..."),
 	}
 }
 
-func applyPunctuation(punct Punctuation, word string) string {
-	apply, ok := PunctuationApplicators()[punct]
+func applyPunctuation(applicators map[Punctuation]Applicator, punct Punctuation, word string) string {
+	apply, ok := applicators[punct]
 
 	if !ok {
 		return word
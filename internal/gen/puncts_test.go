@@ -0,0 +1,83 @@
+package gen_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+func TestContract(t *testing.T) {
+	t.Parallel()
+
+	for word, expected := range map[string]string{
+		"do":    "don't",
+		"Do":    "Don't",
+		"I":     "I'm",
+		"i":     "I'm",
+		"will":  "won't",
+		"world": "world",
+	} {
+		if actual := gen.Contract(gen.EnglishContractions())(word); actual != expected {
+			t.Errorf("expected %q for %q, got: %q", expected, word, actual)
+		}
+	}
+}
+
+func TestPunctuationMarks(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"one", "two", "do", "bar", "it", "qux"}
+
+	for _, testCase := range []struct {
+		name     string
+		punct    gen.Punctuation
+		expected []string
+	}{
+		{"word", gen.Word, []string{"One", "two", "do", "bar", "it", "qux."}},
+		{"hyphen", gen.Hyphen, []string{"One", "two-do-bar-it-qux."}},
+		{"dash", gen.Dash, []string{"One", "two", "–", "do", "–", "bar", "–", "it", "–", "qux."}},
+		{"ellipsis", gen.Ellipsis, []string{"One", "two...", "do...", "bar...", "it...", "qux."}},
+		{"apostrophe", gen.Apostrophe, []string{"One", "two", "don't", "bar", "it's", "qux."}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dist := map[gen.Punctuation]int{testCase.punct: 1_000_000, gen.Period: 1}
+			actual := gen.PunctuationMarks(gen.NewRand(1), words, dist, gen.Languages()["english"])
+
+			if !slices.Equal(testCase.expected, actual) {
+				t.Errorf("expected %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPunctuationMarks_Language(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"eins", "Haus", "geht", "drei"}
+
+	for _, testCase := range []struct {
+		lang     string
+		punct    gen.Punctuation
+		expected []string
+	}{
+		{"german", gen.Quotation, []string{"Eins", "„Haus“", "„geht“", "drei."}},
+		{"german", gen.Apostrophe, []string{"Eins", "Haus", "geht's", "drei."}},
+		{"french", gen.Quotation, []string{"Eins", "«\u00a0Haus\u00a0»", "«\u00a0geht\u00a0»", "drei."}},
+		{"french", gen.Question, []string{"Eins", "Haus\u202f?", "Geht\u202f?", "Drei\u202f?"}},
+		{"spanish", gen.Exclamation, []string{"¡Eins", "Haus!", "¡Geht!", "¡Drei!"}},
+	} {
+		t.Run(testCase.lang, func(t *testing.T) {
+			t.Parallel()
+
+			dist := map[gen.Punctuation]int{testCase.punct: 1_000_000, gen.Period: 1}
+			actual := gen.PunctuationMarks(gen.NewRand(1), words, dist, gen.Languages()[testCase.lang])
+
+			if !slices.Equal(testCase.expected, actual) {
+				t.Errorf("expected %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
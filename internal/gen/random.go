@@ -2,92 +2,57 @@
 package gen
 
 import (
+	"cmp"
 	"math/rand"
-	"slices"
-	"sort"
-	"time"
 )
 
-const MaxNoRepeatWindowSizeFallback = 5
+// Version identifies the generated text for a seed and settings, increase it on every change of the output.
+const Version = 17
 
-var randGen = rand.New(rand.NewSource(time.Now().UnixNano()))
-
-type distRange[E comparable] struct {
-	value   E
-	prevCum int64
-	weight  int
-	cumSum  int64
-}
-
-func MapDistRanges[E comparable](dists map[E]int) (int64, []distRange[E]) {
-	count := len(dists)
-	ranges := make([]distRange[E], count)
-
-	idx := 0
-	cumSum := int64(0)
-
-	for value, weight := range dists {
-		prevCum := cumSum
-		cumSum += int64(weight)
-		ranges[idx] = distRange[E]{value, prevCum, weight, cumSum}
-		idx++
-	}
-
-	return cumSum, ranges
-}
-
-func WeightRecent[E comparable](recent []distRange[E]) int64 {
-	var weight int64
-	for _, r := range recent {
-		weight += int64(r.weight)
-	}
+const (
+	MaxNoRepeatWindowSizeFallback = 5
+	MaxRandomSeed                 = 1_000_000_000
+)
 
-	return weight
+// NewRand returns a source that generates the same text for the same seed.
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
 }
 
-func AddRecentWeightOffset[E comparable](number int64, recent []distRange[E]) int64 {
-	if len(recent) == 0 {
-		return number
-	}
-
-	sorted := slices.Clone(recent)
-	sort.Slice(sorted, func(i int, j int) bool {
-		return sorted[i].prevCum < sorted[j].prevCum
-	})
-
-	for _, r := range sorted {
-		if number > r.prevCum {
-			number += int64(r.weight)
-		}
-	}
-
-	return number
+// RandomSeed returns a short seed that is easy to share.
+func RandomSeed() int64 {
+	return rand.Int63n(MaxRandomSeed-1) + 1
 }
 
-func SampleWeighted[E comparable](count, noRepeatWindow int, dists map[E]int) []E {
+// SampleWeighted draws the values by weight, the values of the recent window aren't repeated.
+// It builds the table on every call, draws of single values in a loop reuse an Alias instead.
+func SampleWeighted[E cmp.Ordered](rnd *rand.Rand, count, noRepeatWindow int, dists map[E]int) []E {
 	result := make([]E, count)
-	sum, ranges := MapDistRanges(dists)
+	alias := NewAlias(dists)
 
-	if noRepeatWindow >= len(ranges) {
-		noRepeatWindow = min(MaxNoRepeatWindowSizeFallback, len(ranges)/2)
+	if noRepeatWindow >= len(alias.values) {
+		noRepeatWindow = min(MaxNoRepeatWindowSizeFallback, len(alias.values)/2)
 	}
 
 	ridx := 0
-	recent := make([]distRange[E], noRepeatWindow)
+	recent := make([]int, noRepeatWindow)
+	blocked := make([]int, len(alias.values)) // occurrences in the recent window
 
-	for i := range count {
-		n := randGen.Int63n(sum-WeightRecent(recent)) + 1
-		n = AddRecentWeightOffset(n, recent)
-
-		s := sort.Search(len(ranges), func(idx int) bool {
-			return ranges[idx].cumSum >= n
-		})
+	for i := range recent {
+		recent[i] = -1
+	}
 
-		selected := ranges[s]
-		result[i] = selected.value
+	for i := range count {
+		idx := alias.sampleExcept(rnd, blocked)
+		result[i] = alias.values[idx]
 
 		if noRepeatWindow > 0 {
-			recent[ridx] = selected
+			if recent[ridx] >= 0 {
+				blocked[recent[ridx]]--
+			}
+
+			recent[ridx] = idx
+			blocked[idx]++
 			ridx = (ridx + 1) % noRepeatWindow
 		}
 	}
@@ -95,7 +60,7 @@ func SampleWeighted[E comparable](count, noRepeatWindow int, dists map[E]int) []
 	return result
 }
 
-func SampleWeightedList(count, noRepeatWindow int, words []string) []string {
+func SampleWeightedList(rnd *rand.Rand, count, noRepeatWindow int, words []string) []string {
 	weight := len(words)
 	dists := make(map[string]int, weight)
 
@@ -103,9 +68,9 @@ func SampleWeightedList(count, noRepeatWindow int, words []string) []string {
 		dists[w] = weight - i
 	}
 
-	return SampleWeighted(count, noRepeatWindow, dists)
+	return SampleWeighted(rnd, count, noRepeatWindow, dists)
 }
 
-func SampleWeightedDist[E comparable](count int, dist map[E]int) []E {
-	return SampleWeighted(count, 0, dist)
+func SampleWeightedDist[E cmp.Ordered](rnd *rand.Rand, count int, dist map[E]int) []E {
+	return SampleWeighted(rnd, count, 0, dist)
 }
@@ -1,58 +1,119 @@
 package gen_test
 
 import (
+	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/dgf/tygo/internal/gen"
 )
 
-func TestSampleWeightedDist(t *testing.T) {
-	t.Parallel()
+// chiSquareCritical holds the critical values of the degrees of freedom at a significance of 0.001.
+var chiSquareCritical = map[int]float64{1: 10.83, 3: 16.27, 9: 27.88, 49: 85.35}
 
-	count := 100
-	dist := map[string]int{"foo": 7, "bar": 3}
-	list := gen.SampleWeightedDist(count, dist)
+// chiSquare returns the statistic of the observed counts of the values to the expected distribution.
+func chiSquare[E comparable](list []E, dist map[E]int) float64 {
+	counts := make(map[E]int, len(dist))
+	for _, v := range list {
+		counts[v]++
+	}
 
-	if count != len(list) {
-		t.Fatalf("expected %d results, got: %d", count, len(list))
+	sum := 0
+	for _, w := range dist {
+		sum += w
 	}
 
-	counts := make(map[string]int, len(dist))
-	for _, a := range list {
-		counts[a]++
+	statistic := 0.0
+
+	for v, w := range dist {
+		expected := float64(len(list)) * float64(w) / float64(sum)
+		diff := float64(counts[v]) - expected
+		statistic += diff * diff / expected
 	}
 
-	if counts["foo"] < count/2 {
-		t.Errorf("expected more than %d of foo, got %d", count/2, counts["foo"])
+	return statistic
+}
+
+func TestSampleWeightedDist(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name string
+		dist map[string]int
+	}{
+		{"two", map[string]int{"foo": 7, "bar": 3}},
+		{"four", map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}},
+		{"skewed", map[string]int{"a": 1000, "b": 1, "c": 10, "d": 100}},
+		{"ten", map[string]int{"0": 5, "1": 1, "2": 9, "3": 2, "4": 8, "5": 3, "6": 7, "7": 4, "8": 6, "9": 5}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			count := 100_000
+			list := gen.SampleWeightedDist(gen.NewRand(1), count, testCase.dist)
+
+			if count != len(list) {
+				t.Fatalf("expected %d results, got: %d", count, len(list))
+			}
+
+			critical := chiSquareCritical[len(testCase.dist)-1]
+			if statistic := chiSquare(list, testCase.dist); statistic > critical {
+				t.Errorf("expected distribution of the weights, got chi-square %.2f > %.2f", statistic, critical)
+			}
+		})
 	}
+}
+
+func TestSampleWeightedDist_ZeroWeight(t *testing.T) {
+	t.Parallel()
 
-	if counts["bar"] > count/2 {
-		t.Errorf("expected less than %d of bar, got %d", count/2, counts["bar"])
+	for _, v := range gen.SampleWeightedDist(gen.NewRand(1), 1000, map[string]int{"foo": 0, "bar": 1, "baz": 0}) {
+		if v != "bar" {
+			t.Fatalf("expected only weighted values, got: %q", v)
+		}
 	}
 }
 
 func TestSampleWeightedList(t *testing.T) {
 	t.Parallel()
 
-	count := 1000
-	words := []string{"foo", "bar"}
-	list := gen.SampleWeightedList(count, 0, words)
+	count := 100_000
+	words := make([]string, 50)
+	dist := make(map[string]int, len(words))
+
+	for i := range words {
+		words[i] = strconv.Itoa(i)
+		dist[words[i]] = len(words) - i
+	}
+
+	list := gen.SampleWeightedList(gen.NewRand(1), count, 0, words)
 
 	if count != len(list) {
 		t.Fatalf("expected %d results, got: %d", count, len(list))
 	}
 
-	counts := make(map[string]int, len(words))
-	for _, a := range list {
-		counts[a]++
+	critical := chiSquareCritical[len(words)-1]
+	if statistic := chiSquare(list, dist); statistic > critical {
+		t.Errorf("expected distribution of the ranks, got chi-square %.2f > %.2f", statistic, critical)
 	}
+}
+
+func TestSampleWeightedList_NoRepeatWindow(t *testing.T) {
+	t.Parallel()
+
+	window := 5
+	words := make([]string, 100)
 
-	if counts["foo"] < count/2 {
-		t.Errorf("expected more than %d of foo, got %d", count/2, counts["foo"])
+	for i := range words {
+		words[i] = strconv.Itoa(i)
 	}
 
-	if counts["bar"] > count/2 {
-		t.Errorf("expected less than %d of bar, got %d", count/2, counts["bar"])
+	list := gen.SampleWeightedList(gen.NewRand(1), 10_000, window, words)
+
+	for i, w := range list {
+		if recent := list[max(0, i-window):i]; slices.Contains(recent, w) {
+			t.Fatalf("expected no repeat of %q within %d words, got: %q", w, window, recent)
+		}
 	}
 }
 
@@ -61,7 +122,7 @@ func TestSampleWeightedList_UniqLenMinusOne(t *testing.T) {
 
 	count := 100
 	words := []string{"one", "two", "foo", "bar", "baz"}
-	list := gen.SampleWeightedList(count, len(words)-1, words)
+	list := gen.SampleWeightedList(gen.NewRand(1), count, len(words)-1, words)
 
 	if count != len(list) {
 		t.Fatalf("expected %d results, got: %d", count, len(list))
@@ -79,3 +140,66 @@ func TestSampleWeightedList_UniqLenMinusOne(t *testing.T) {
 		}
 	}
 }
+
+func TestNewRand_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"one", "two", "foo", "bar", "baz"}
+	dist := map[gen.Punctuation]int{gen.Word: 5, gen.Period: 2, gen.Comma: 2, gen.Quotation: 1}
+
+	generate := func(seed int64) []string {
+		rnd := gen.NewRand(seed)
+		list := gen.SampleWeightedList(rnd, 50, 2, words)
+		list = gen.WithNumbers(rnd, 20, list, map[gen.NumberFormat]int{gen.Integer: 2, gen.Date: 1}, gen.Locale{})
+
+		return gen.PunctuationMarks(rnd, list, dist, gen.Languages()["english"])
+	}
+
+	first := generate(42)
+
+	for range 10 {
+		if next := generate(42); !slices.Equal(first, next) {
+			t.Fatalf("expected same text for same seed, got:\n%v\n%v", first, next)
+		}
+	}
+
+	if other := generate(43); slices.Equal(first, other) {
+		t.Errorf("expected different text for another seed, got: %v", other)
+	}
+}
+
+func BenchmarkSampleWeightedList(b *testing.B) {
+	words := make([]string, 10_000)
+	for i := range words {
+		words[i] = strconv.Itoa(i)
+	}
+
+	rnd := gen.NewRand(1)
+
+	for b.Loop() {
+		gen.SampleWeightedList(rnd, 1000, gen.MaxNoRepeatWindowSizeFallback, words)
+	}
+}
+
+func BenchmarkAlias_Sample(b *testing.B) {
+	dist := make(map[int]int, 10_000)
+	for i := range 10_000 {
+		dist[i] = 10_000 - i
+	}
+
+	alias := gen.NewAlias(dist)
+	rnd := gen.NewRand(1)
+
+	for b.Loop() {
+		alias.Sample(rnd)
+	}
+}
+
+func BenchmarkSampleWeightedDist_One(b *testing.B) {
+	dist := map[gen.NumberFormat]int{gen.Integer: 5, gen.Decimal: 2, gen.Date: 1, gen.Phone: 1}
+	rnd := gen.NewRand(1)
+
+	for b.Loop() {
+		gen.SampleWeightedDist(rnd, 1, dist)
+	}
+}
@@ -0,0 +1,140 @@
+package gen
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// Sentence and clause lengths in words.
+const (
+	MinSentenceWords = 4
+	MinClauseWords   = 3
+	MaxClauseWords   = 8
+	MinFrameWords    = 2
+	MaxFrameWords    = 4
+	sentenceSpread   = 6
+)
+
+type clause struct {
+	start int
+	end   int // exclusive
+}
+
+// Sentences structures the words as sentences of plausible length,
+// with commas between clauses and quotes or parentheses around spans of words.
+func Sentences(rnd *rand.Rand, words []string, dist map[Punctuation]int, lang Language) []string {
+	result := slices.Clone(words)
+
+	for start := 0; start < len(result); {
+		end := min(len(result), start+MinSentenceWords+rnd.Intn(sentenceSpread)+rnd.Intn(sentenceSpread))
+		if len(result)-end < MinSentenceWords {
+			end = len(result)
+		}
+
+		sentence(rnd, result[start:end], dist, lang)
+		start = end
+	}
+
+	return compose(result)
+}
+
+// sentence punctuates the words in place.
+func sentence(rnd *rand.Rand, words []string, dist map[Punctuation]int, lang Language) {
+	applicators := PunctuationApplicators(lang)
+	clauses := splitClauses(rnd, len(words))
+	opens, closes := enframeSpan(rnd, words, clauses, dist, lang)
+
+	marks := sampleSub(rnd, len(words), dist, Word, Apostrophe, Hyphen)
+	breaks := sampleSub(rnd, len(clauses), dist, Comma, Semicolon, Colon, Dash, Ellipsis)
+	end := sampleSub(rnd, 1, dist, Period, Question, Exclamation)[0]
+
+	for i, mark := range marks {
+		// compounds stay inside of clauses and frames
+		if mark == Hyphen && (i == len(words)-1 || closes[i] || opens[i+1] || isClauseEnd(clauses, i)) {
+			continue
+		}
+
+		words[i] = applyPunctuation(applicators, mark, words[i])
+	}
+
+	for c, cl := range clauses[:len(clauses)-1] {
+		words[cl.end-1] = applyPunctuation(applicators, breaks[c], words[cl.end-1])
+	}
+
+	words[len(words)-1] = applyPunctuation(applicators, end, words[len(words)-1])
+	words[0] = capitalize(words[0])
+
+	if lang.Inverted {
+		words[0] = invertedMarks()[end] + words[0]
+	}
+}
+
+// splitClauses divides the words into clauses of at least the minimum length.
+func splitClauses(rnd *rand.Rand, count int) []clause {
+	clauses := []clause{}
+
+	for start := 0; start < count; {
+		end := min(count, start+MinClauseWords+rnd.Intn(MaxClauseWords-MinClauseWords+1))
+		if count-end < MinClauseWords {
+			end = count
+		}
+
+		clauses = append(clauses, clause{start: start, end: end})
+		start = end
+	}
+
+	return clauses
+}
+
+func isClauseEnd(clauses []clause, i int) bool {
+	return slices.ContainsFunc(clauses, func(c clause) bool {
+		return c.end-1 == i
+	})
+}
+
+// enframeSpan encloses some words of a clause, if a frame is sampled for the sentence and the clause is long enough.
+func enframeSpan(
+	rnd *rand.Rand, words []string, clauses []clause, dist map[Punctuation]int, lang Language,
+) ([]bool, []bool) {
+	opens := make([]bool, len(words)+1)
+	closes := make([]bool, len(words))
+
+	frame := sampleSub(rnd, 1, dist, Word, Quotation, Brackets, Braces, Parenthesis)[0]
+	if frame == Word {
+		return opens, closes
+	}
+
+	cl := clauses[rnd.Intn(len(clauses))]
+	if cl.end-cl.start < MinFrameWords {
+		return opens, closes
+	}
+
+	length := MinFrameWords + rnd.Intn(min(MaxFrameWords, cl.end-cl.start)-MinFrameWords+1)
+	start := cl.start + rnd.Intn(cl.end-cl.start-length+1)
+	end := start + length - 1
+	marks := lang.Frames()[frame]
+
+	words[start] = marks[0] + words[start]
+	words[end] += marks[1]
+	opens[start] = true
+	closes[end] = true
+
+	return opens, closes
+}
+
+// sampleSub samples from the weights of the given punctuation only, the first one if none is weighted.
+func sampleSub(rnd *rand.Rand, count int, dist map[Punctuation]int, puncts ...Punctuation) []Punctuation {
+	sub := make(map[Punctuation]int, len(puncts))
+	sum := 0
+
+	for _, p := range puncts {
+		sub[p] = dist[p]
+		sum += dist[p]
+	}
+
+	if sum == 0 {
+		sub[puncts[0]] = 1
+	}
+
+	return SampleWeightedDist(rnd, count, sub)
+}
@@ -0,0 +1,64 @@
+package gen_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+func TestSentences(t *testing.T) {
+	t.Parallel()
+
+	words := strings.Fields(strings.Repeat("one two foo bar baz ", 20))
+	dist := map[gen.Punctuation]int{
+		gen.Word: 10, gen.Period: 3, gen.Question: 1, gen.Comma: 2, gen.Parenthesis: 5, gen.Quotation: 5,
+	}
+
+	for seed := range int64(20) {
+		text := gen.Sentences(gen.NewRand(seed), words, dist, gen.Languages()["english"])
+		length := 0
+
+		for i, w := range text {
+			length++
+
+			if (i == 0 || strings.ContainsAny(text[i-1], ".?!")) && !unicode.IsUpper([]rune(strings.Trim(w, "(\""))[0]) {
+				t.Errorf("seed %d: expected capitalized sentence start at %d, got: %q", seed, i, w)
+			}
+
+			if strings.HasPrefix(w, "(") && strings.Contains(w, ")") || strings.Count(w, "\"") == 2 {
+				t.Errorf("seed %d: expected frames around at least %d words, got: %q", seed, gen.MinFrameWords, w)
+			}
+
+			if strings.ContainsAny(w, ".?!") {
+				if length < gen.MinSentenceWords {
+					t.Errorf("seed %d: expected at least %d words, got: %d", seed, gen.MinSentenceWords, length)
+				}
+
+				length = 0
+			}
+		}
+
+		if length > 0 {
+			t.Errorf("seed %d: expected closed last sentence, got: %q", seed, text[len(text)-1])
+		}
+
+		joined := strings.Join(text, " ")
+		if strings.Count(joined, "(") != strings.Count(joined, ")") || strings.Count(joined, "\"")%2 != 0 {
+			t.Errorf("seed %d: expected balanced frames, got: %s", seed, joined)
+		}
+	}
+}
+
+func TestSentences_SingleWord(t *testing.T) {
+	t.Parallel()
+
+	dist := map[gen.Punctuation]int{gen.Period: 1, gen.Parenthesis: 1}
+
+	for seed := range int64(20) {
+		if text := gen.Sentences(gen.NewRand(seed), []string{"one"}, dist, gen.Languages()["english"]); text[0] != "One." {
+			t.Errorf("seed %d: expected an unframed word, got: %q", seed, text)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package gen
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// Symbols decorates the words with programming syntax, the last word stays plain.
+func Symbols(rnd *rand.Rand, words []string, dist map[Punctuation]int) []string {
+	result := slices.Clone(words)
+	applicators := SymbolApplicators()
+
+	if sum(dist) == 0 {
+		return result
+	}
+
+	for p, symbol := range SampleWeightedDist(rnd, len(words)-1, dist) {
+		// no prefix on the second part of a joined name
+		if p > 0 && joined(result[p-1]) && slices.Contains(prefixing(), symbol) {
+			symbol = Word
+		}
+
+		result[p] = applyPunctuation(applicators, symbol, result[p])
+	}
+
+	return compose(result)
+}
+
+func prefixing() []Punctuation {
+	return []Punctuation{Include, Variable, Tag}
+}
+
+func SymbolApplicators() map[Punctuation]Applicator {
+	return map[Punctuation]Applicator{
+		Word:       Echo,
+		SnakeCase:  Append("_" + joinMark),
+		CamelCase:  Append(camelMark),
+		Call:       Append("()"),
+		Arrow:      Append("->" + joinMark),
+		NotEqual:   Append(" !="),
+		LogicalAnd: Append(" &&"),
+		LogicalOr:  Append(" ||"),
+		Include:    Enframe("#include <", ".h>"),
+		Variable:   Enframe("$", ""),
+		Tag:        Enframe("<", ">"),
+		Index:      Append("[i]"),
+		Assign:     Append(" ="),
+	}
+}
@@ -0,0 +1,54 @@
+package gen_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dgf/tygo/internal/gen"
+)
+
+func TestSymbols(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"one", "two", "foo", "bar"}
+
+	for _, testCase := range []struct {
+		name     string
+		symbol   gen.Punctuation
+		expected []string
+	}{
+		{"snake case", gen.SnakeCase, []string{"one_two_foo_bar"}},
+		{"camel case", gen.CamelCase, []string{"oneTwoFooBar"}},
+		{"call", gen.Call, []string{"one()", "two()", "foo()", "bar"}},
+		{"arrow", gen.Arrow, []string{"one->two->foo->bar"}},
+		{"not equal", gen.NotEqual, []string{"one", "!=", "two", "!=", "foo", "!=", "bar"}},
+		{"include", gen.Include, []string{"#include", "<one.h>", "#include", "<two.h>", "#include", "<foo.h>", "bar"}},
+		{"variable", gen.Variable, []string{"$one", "$two", "$foo", "bar"}},
+		{"tag", gen.Tag, []string{"<one>", "<two>", "<foo>", "bar"}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := gen.Symbols(gen.NewRand(1), words, map[gen.Punctuation]int{testCase.symbol: 1})
+
+			if !slices.Equal(testCase.expected, actual) {
+				t.Errorf("expected %q, got: %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSymbols_NoPrefixInName(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"one", "two", "foo", "bar", "baz"}
+	dist := map[gen.Punctuation]int{gen.SnakeCase: 1, gen.Variable: 1}
+
+	for seed := range int64(20) {
+		for _, w := range gen.Symbols(gen.NewRand(seed), words, dist) {
+			if i := slices.Index([]rune(w), '$'); i > 0 {
+				t.Errorf("seed %d: expected variable prefix at the start only, got: %q", seed, w)
+			}
+		}
+	}
+}
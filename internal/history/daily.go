@@ -0,0 +1,45 @@
+package history
+
+import (
+	"time"
+
+	"github.com/dgf/tygo/internal/test"
+)
+
+// Daily records the daily challenge of a date, repeated attempts as practice.
+type Daily struct {
+	File     File
+	Date     string
+	Practice bool
+}
+
+// Daily checks the history for an attempt of the challenge at the UTC date.
+func (f File) Daily(date time.Time) (Daily, error) {
+	d := Daily{File: f, Date: date.UTC().Format(time.DateOnly), Practice: false}
+
+	records, err := f.Load()
+	if err != nil {
+		return d, err
+	}
+
+	for _, r := range records {
+		if r.Daily == d.Date {
+			d.Practice = true
+
+			break
+		}
+	}
+
+	return d, nil
+}
+
+// Record appends the summary, any following attempt is practice.
+func (d *Daily) Record(summary test.Summary) error {
+	r := NewRecord(summary)
+	r.Daily = d.Date
+	r.Practice = d.Practice
+
+	d.Practice = true
+
+	return d.File.Append(r)
+}
@@ -0,0 +1,109 @@
+// Package history stores the results of finished typing tests as JSON lines.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/dgf/tygo/internal/test"
+)
+
+const (
+	appDirName   = "tygo"
+	histFileName = "history.jsonl"
+)
+
+// Record groups the results of one set of rounds.
+type Record struct {
+	Time     time.Time     `json:"time"`
+	Daily    string        `json:"daily,omitempty"` // date of the daily challenge
+	Practice bool          `json:"practice,omitempty"`
+	WarmUp   bool          `json:"warmUp,omitempty"`
+	Rounds   []test.Result `json:"rounds"`
+}
+
+// File is a history file, one record per line.
+type File struct {
+	Name string
+}
+
+func UserFile() (File, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return File{}, fmt.Errorf("user config dir access failed: %w", err)
+	}
+
+	return File{Name: path.Join(dir, appDirName, histFileName)}, nil
+}
+
+func NewRecord(summary test.Summary) Record {
+	return Record{Time: time.Now(), Daily: "", Practice: false, WarmUp: summary.WarmUp, Rounds: summary.Rounds}
+}
+
+// Record appends the summary as a new record.
+func (f File) Record(summary test.Summary) error {
+	return f.Append(NewRecord(summary))
+}
+
+func (f File) Append(record Record) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("history record marshal failed: %w", err)
+	}
+
+	err = os.MkdirAll(path.Dir(f.Name), 0o700)
+	if err != nil {
+		return fmt.Errorf("make history dir failed: %w", err)
+	}
+
+	file, err := os.OpenFile(f.Name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("history open failed: %w", err)
+	}
+
+	_, err = file.Write(append(b, '\n'))
+
+	return errors.Join(err, file.Close())
+}
+
+// Load reads all records, a missing file is an empty history.
+func (f File) Load() ([]Record, error) {
+	file, err := os.Open(f.Name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("history open failed: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := []Record{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var r Record
+
+		err = json.Unmarshal(scanner.Bytes(), &r)
+		if err != nil {
+			return records, fmt.Errorf("history record %d unmarshal failed: %w", len(records)+1, err)
+		}
+
+		records = append(records, r)
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return records, fmt.Errorf("history read failed: %w", err)
+	}
+
+	return records, nil
+}
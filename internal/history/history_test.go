@@ -0,0 +1,83 @@
+package history_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/dgf/tygo/internal/history"
+	"github.com/dgf/tygo/internal/test"
+)
+
+func TestFile_AppendLoad(t *testing.T) {
+	t.Parallel()
+
+	file := history.File{Name: path.Join(t.TempDir(), "tygo", "history.jsonl")}
+
+	records, err := file.Load()
+	if err != nil || len(records) != 0 {
+		t.Fatalf("expected empty history, got: %v, %v", records, err)
+	}
+
+	rounds := []test.Result{
+		{Mode: test.ModeNormal, Duration: time.Minute, WordsPerMinute: 50, AccuracyPercent: 90,
+			AdjustedWordsPerMinute: 45, Words: 50, ErrorWords: 5, Idle: 0, Seed: 1, Code: ""},
+		{Mode: test.ModeNormal, Duration: time.Minute, WordsPerMinute: 60, AccuracyPercent: 100,
+			AdjustedWordsPerMinute: 60, Words: 60, ErrorWords: 0, Idle: time.Second, Seed: 2, Code: "1.AA"},
+	}
+
+	for _, summary := range []test.Summary{test.Summarize(rounds[:1], false), test.Summarize(rounds, true)} {
+		err = file.Record(summary)
+		if err != nil {
+			t.Fatalf("unexpected record error: %v", err)
+		}
+	}
+
+	records, err = file.Load()
+	if err != nil {
+		t.Fatalf("unexpected load error: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got: %d", len(records))
+	}
+
+	if last := records[1]; !last.WarmUp || len(last.Rounds) != 2 || last.Rounds[1] != rounds[1] {
+		t.Errorf("expected grouped rounds with warm-up, got: %+v", last)
+	}
+}
+
+func TestFile_Daily(t *testing.T) {
+	t.Parallel()
+
+	file := history.File{Name: path.Join(t.TempDir(), "history.jsonl")}
+	date := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.Local)
+	summary := test.Summarize([]test.Result{{}}, false)
+
+	for _, practice := range []bool{false, true} {
+		daily, err := file.Daily(date)
+		if err != nil {
+			t.Fatalf("unexpected daily error: %v", err)
+		}
+
+		if daily.Practice != practice {
+			t.Errorf("expected practice %t, got: %t", practice, daily.Practice)
+		}
+
+		err = daily.Record(summary)
+		if err != nil {
+			t.Fatalf("unexpected record error: %v", err)
+		}
+	}
+
+	records, err := file.Load()
+	if err != nil {
+		t.Fatalf("unexpected load error: %v", err)
+	}
+
+	for i, r := range records {
+		if r.Daily != "2026-10-19" || r.Practice != (i > 0) {
+			t.Errorf("unexpected daily record %d: %+v", i, r)
+		}
+	}
+}
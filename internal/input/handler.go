@@ -17,5 +17,13 @@ func KeyEvents() map[KeyCode]test.Event {
 		KeyBackspace: test.EventBackRune,
 		KeyCtrlW:     test.EventBackWord,
 		KeyTab:       test.EventReset,
+		KeyCtrlP:     test.EventPause,
+	}
+}
+
+func SequenceEvents() map[string]test.Event {
+	return map[string]test.Event{
+		SeqFocusIn:  test.EventFocusIn,
+		SeqFocusOut: test.EventFocusOut,
 	}
 }
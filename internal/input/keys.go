@@ -8,9 +8,16 @@ const (
 	KeyCtrlD     KeyCode = 4
 	KeyTab       KeyCode = 9
 	KeyEnter     KeyCode = 13
+	KeyCtrlP     KeyCode = 16
 	KeyCtrlW     KeyCode = 23
 	KeyEscape    KeyCode = 27
 	KeyBackspace KeyCode = 127
 
 	MaxControlCode = 31
 )
+
+// Escape sequences of terminal focus reporting.
+const (
+	SeqFocusIn  = "\033[I"
+	SeqFocusOut = "\033[O"
+)
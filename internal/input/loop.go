@@ -29,6 +29,12 @@ func Loop(in io.Reader, handler Handler) {
 			}
 		}
 
+		if e, ok := SequenceEvents()[string(buf[:count])]; ok {
+			quit = handler.HandleEvent(e)
+
+			continue
+		}
+
 		if buf[0] > MaxControlCode && utf8.FullRune(buf[:count]) {
 			r, _ := utf8.DecodeRune(buf[:count])
 
@@ -0,0 +1,256 @@
+// Package share encodes the settings and seed of a generated text as a compact code.
+package share
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dgf/tygo/internal/config"
+	"github.com/dgf/tygo/internal/gen"
+)
+
+const separator = "."
+
+// Limits of the decoded values, larger ones are refused as invalid.
+const (
+	MaxTopWords  = 1_000_000
+	MaxWordCount = 10_000
+	MaxWeight    = 1_000_000
+)
+
+const (
+	flagNumbers = 1 << iota
+	flagPunctuation
+	flagSentences
+	flagSymbols
+	flagsAll = flagSymbols<<1 - 1
+)
+
+// Code holds everything that determines a generated text.
+type Code struct {
+	Version      int
+	Dictionary   string
+	Language     string
+	Generator    string
+	Charset      string
+	Case         string
+	TopWords     int
+	WordCount    int
+	NoRepeat     int
+	CaseWeight   int
+	Numbers      bool
+	Punctuation  bool
+	Sentences    bool
+	Symbols      bool
+	Distribution config.Distribution
+	NumberFormat config.NumberFormats
+	SymbolFreqs  config.SymbolDistribution
+	Seed         int64
+}
+
+type InvalidCodeError struct {
+	Code string
+}
+
+func (e *InvalidCodeError) Error() string {
+	return fmt.Sprintf("invalid code %q", e.Code)
+}
+
+type VersionError struct {
+	Version int
+	Current int
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("code of generator version %d can't be used with version %d", e.Version, e.Current)
+}
+
+func FromConfig(cfg config.Config) Code {
+	return Code{
+		Version:      gen.Version,
+		Dictionary:   cfg.Dictionary,
+		Language:     cfg.Language,
+		Generator:    cfg.Generator,
+		Charset:      cfg.Charset,
+		Case:         cfg.Case,
+		TopWords:     cfg.TopWords,
+		WordCount:    cfg.WordCount,
+		NoRepeat:     cfg.NoRepeat,
+		CaseWeight:   cfg.CaseWeight,
+		Numbers:      cfg.Numbers,
+		Punctuation:  cfg.Punctuation,
+		Sentences:    cfg.Sentences,
+		Symbols:      cfg.Symbols,
+		Distribution: cfg.Distribution,
+		NumberFormat: cfg.NumberFormat,
+		SymbolFreqs:  cfg.SymbolFreqs,
+		Seed:         cfg.Seed,
+	}
+}
+
+// Apply overrides the text settings of the config.
+func (c Code) Apply(cfg *config.Config) {
+	cfg.Dictionary = c.Dictionary
+	cfg.Language = c.Language
+	cfg.Generator = c.Generator
+	cfg.Charset = c.Charset
+	cfg.Case = c.Case
+	cfg.CaseWeight = c.CaseWeight
+	cfg.Source = config.Default().Source // only generated texts are shareable
+	cfg.Corpus = ""                      // only the bundled ones are shareable
+	cfg.TopWords = c.TopWords
+	cfg.WordCount = c.WordCount
+	cfg.NoRepeat = c.NoRepeat
+	cfg.Numbers = c.Numbers
+	cfg.Punctuation = c.Punctuation
+	cfg.Sentences = c.Sentences
+	cfg.Symbols = c.Symbols
+	cfg.Distribution = c.Distribution
+	cfg.NumberFormat = c.NumberFormat
+	cfg.SymbolFreqs = c.SymbolFreqs
+	cfg.Seed = c.Seed
+}
+
+// weights lists the distributions in a fixed order.
+func weights(d *config.Distribution, n *config.NumberFormats, s *config.SymbolDistribution) []*int {
+	return []*int{
+		&d.Word, &d.Number, &d.Period, &d.Comma, &d.Quotation, &d.Question,
+		&d.Exclamation, &d.Brackets, &d.Braces, &d.Parenthesis, &d.Colon, &d.Semicolon,
+		&d.Apostrophe, &d.Dash, &d.Hyphen, &d.Ellipsis,
+		&n.Integer, &n.Decimal, &n.Percent, &n.Date, &n.Time, &n.Currency, &n.Phone, &n.Version, &n.Range,
+		&s.Word, &s.SnakeCase, &s.CamelCase, &s.Call, &s.Arrow, &s.NotEqual, &s.LogicalAnd, &s.LogicalOr,
+		&s.Include, &s.Variable, &s.Tag, &s.Index, &s.Assign,
+	}
+}
+
+// names lists the text settings in a fixed order.
+func names(c *Code) []*string {
+	return []*string{&c.Dictionary, &c.Language, &c.Generator, &c.Charset, &c.Case}
+}
+
+// String returns the code as version and URL-safe base64 payload.
+func (c Code) String() string {
+	flags := 0
+	if c.Numbers {
+		flags |= flagNumbers
+	}
+
+	if c.Punctuation {
+		flags |= flagPunctuation
+	}
+
+	if c.Sentences {
+		flags |= flagSentences
+	}
+
+	if c.Symbols {
+		flags |= flagSymbols
+	}
+
+	b := binary.AppendVarint(nil, c.Seed)
+	b = binary.AppendUvarint(b, uint64(c.TopWords))
+	b = binary.AppendUvarint(b, uint64(c.WordCount))
+	b = binary.AppendUvarint(b, uint64(c.NoRepeat))
+	b = binary.AppendUvarint(b, uint64(c.CaseWeight))
+	b = binary.AppendUvarint(b, uint64(flags))
+
+	for _, w := range weights(&c.Distribution, &c.NumberFormat, &c.SymbolFreqs) {
+		b = binary.AppendUvarint(b, uint64(*w))
+	}
+
+	for _, name := range names(&c) {
+		b = binary.AppendUvarint(b, uint64(len(*name)))
+		b = append(b, *name...)
+	}
+
+	return strconv.Itoa(c.Version) + separator + base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Parse decodes a code, codes of another generator version are refused.
+func Parse(code string) (Code, error) {
+	c := Code{}
+
+	prefix, payload, ok := strings.Cut(strings.TrimSpace(code), separator)
+	if !ok {
+		return c, &InvalidCodeError{Code: code}
+	}
+
+	version, err := strconv.Atoi(prefix)
+	if err != nil {
+		return c, &InvalidCodeError{Code: code}
+	}
+
+	if version != gen.Version {
+		return c, &VersionError{Version: version, Current: gen.Version}
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return c, &InvalidCodeError{Code: code}
+	}
+
+	r := bytes.NewReader(b)
+
+	c.Version = version
+
+	c.Seed, err = binary.ReadVarint(r)
+	if err != nil {
+		return c, &InvalidCodeError{Code: code}
+	}
+
+	var flags int
+
+	values := []*int{&c.TopWords, &c.WordCount, &c.NoRepeat, &c.CaseWeight, &flags}
+	limits := []int{MaxTopWords, MaxWordCount, MaxWordCount, 100, flagsAll}
+
+	for _, w := range weights(&c.Distribution, &c.NumberFormat, &c.SymbolFreqs) {
+		values = append(values, w)
+		limits = append(limits, MaxWeight)
+	}
+
+	for i, v := range values {
+		n, err := binary.ReadUvarint(r)
+		if err != nil || n > uint64(limits[i]) {
+			return c, &InvalidCodeError{Code: code}
+		}
+
+		*v = int(n)
+	}
+
+	if c.WordCount < 1 {
+		return c, &InvalidCodeError{Code: code}
+	}
+
+	c.Numbers = flags&flagNumbers != 0
+	c.Punctuation = flags&flagPunctuation != 0
+	c.Sentences = flags&flagSentences != 0
+	c.Symbols = flags&flagSymbols != 0
+
+	for _, name := range names(&c) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil || n > uint64(r.Len()) {
+			return c, &InvalidCodeError{Code: code}
+		}
+
+		v := make([]byte, n)
+		_, _ = r.Read(v)
+		*name = string(v)
+	}
+
+	if r.Len() > 0 {
+		return c, &InvalidCodeError{Code: code}
+	}
+
+	return c, nil
+}
+
+// Share returns the code of the text generated with the seed.
+func (c Code) Share(seed int64) string {
+	c.Seed = seed
+
+	return c.String()
+}
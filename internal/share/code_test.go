@@ -0,0 +1,162 @@
+package share_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgf/tygo/internal/config"
+	"github.com/dgf/tygo/internal/gen"
+	"github.com/dgf/tygo/internal/share"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Default()
+	cfg.Dictionary = "german"
+	cfg.Language = "french"
+	cfg.Generator = "pseudo"
+	cfg.Charset = "asdfjkl"
+	cfg.Case = "random"
+	cfg.CaseWeight = 50
+	cfg.TopWords = 1000
+	cfg.Numbers = true
+	cfg.Distribution.Comma = 42
+	cfg.Seed = 123456789
+
+	code := share.FromConfig(cfg)
+
+	parsed, err := share.Parse(code.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if parsed != code {
+		t.Errorf("expected %+v, got: %+v", code, parsed)
+	}
+
+	applied := config.Default()
+	parsed.Apply(&applied)
+
+	if share.FromConfig(applied) != code {
+		t.Errorf("expected applied settings %+v, got: %+v", code, share.FromConfig(applied))
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range []string{
+		"", "abc", "x.AAAA", strconv.Itoa(gen.Version) + ".!!", strconv.Itoa(gen.Version) + ".gA",
+	} {
+		_, err := share.Parse(code)
+
+		var invalidErr *share.InvalidCodeError
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("expected invalid code error for %q, got: %v", code, err)
+		}
+	}
+}
+
+func TestParse_OutOfRange(t *testing.T) {
+	t.Parallel()
+
+	trailing := share.FromConfig(config.Default()).String()
+	prefix, payload, _ := strings.Cut(trailing, ".")
+	data, _ := base64.RawURLEncoding.DecodeString(payload)
+	trailing = prefix + "." + base64.RawURLEncoding.EncodeToString(append(data, 0))
+
+	for _, testCase := range []struct {
+		name   string
+		change func(c *share.Code)
+	}{
+		{"negative count", func(c *share.Code) { c.WordCount = -5 }},
+		{"no words", func(c *share.Code) { c.WordCount = 0 }},
+		{"huge count", func(c *share.Code) { c.WordCount = share.MaxWordCount + 1 }},
+		{"huge top words", func(c *share.Code) { c.TopWords = share.MaxTopWords + 1 }},
+		{"negative weight", func(c *share.Code) { c.Distribution.Comma = -1 }},
+		{"huge weight", func(c *share.Code) { c.NumberFormat.Phone = share.MaxWeight + 1 }},
+		{"case weight", func(c *share.Code) { c.CaseWeight = 101 }},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			code := share.FromConfig(config.Default())
+			testCase.change(&code)
+
+			_, err := share.Parse(code.String())
+
+			var invalidErr *share.InvalidCodeError
+			if !errors.As(err, &invalidErr) {
+				t.Errorf("expected invalid code error, got: %v", err)
+			}
+		})
+	}
+
+	_, err := share.Parse(trailing)
+
+	var invalidErr *share.InvalidCodeError
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected invalid code error of trailing bytes, got: %v", err)
+	}
+}
+
+func TestParse_Version(t *testing.T) {
+	t.Parallel()
+
+	code := share.FromConfig(config.Default())
+	code.Version = gen.Version + 1
+
+	_, err := share.Parse(code.String())
+
+	var versionErr *share.VersionError
+	if !errors.As(err, &versionErr) {
+		t.Fatalf("expected version error, got: %v", err)
+	}
+
+	if versionErr.Version != gen.Version+1 || versionErr.Current != gen.Version {
+		t.Errorf("unexpected versions: %+v", versionErr)
+	}
+}
+
+func TestApplyDailyRules(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Default()
+	cfg.StrictMode = true
+	cfg.WordMode = true
+	cfg.StopOnError = "word"
+	cfg.Confidence = true
+	cfg.Rounds = 5
+
+	share.ApplyDailyRules(&cfg)
+
+	if cfg.StrictMode || cfg.WordMode || cfg.StopOnError != "off" || cfg.Confidence || cfg.Rounds != 1 {
+		t.Errorf("expected the pinned daily rules, got: %+v", cfg)
+	}
+}
+
+func TestDaily(t *testing.T) {
+	t.Parallel()
+
+	morning := time.Date(2026, time.October, 19, 6, 0, 0, 0, time.UTC)
+	evening := time.Date(2026, time.October, 19, 23, 0, 0, 0, time.UTC)
+	nextDay := time.Date(2026, time.October, 20, 6, 0, 0, 0, time.UTC)
+
+	if share.Daily(morning) != share.Daily(evening) {
+		t.Errorf("expected the same code on a date, got: %v and %v", share.Daily(morning), share.Daily(evening))
+	}
+
+	if share.Daily(morning).Seed == share.Daily(nextDay).Seed {
+		t.Errorf("expected another seed on the next day, got: %d", share.Daily(nextDay).Seed)
+	}
+
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	if late := evening.In(tokyo); share.Daily(late) != share.Daily(evening) {
+		t.Errorf("expected the code of the UTC date in other zones, got seed: %d", share.Daily(late).Seed)
+	}
+}
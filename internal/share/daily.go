@@ -0,0 +1,29 @@
+package share
+
+import (
+	"time"
+
+	"github.com/dgf/tygo/internal/config"
+)
+
+// Daily returns the code of the daily challenge, the same for everyone on a UTC date.
+func Daily(date time.Time) Code {
+	year, month, day := date.UTC().Date()
+
+	c := FromConfig(config.Default())
+	c.Seed = int64(year*10000 + int(month)*100 + day)
+
+	return c
+}
+
+// ApplyDailyRules pins the rules of the daily challenge, so all attempts are typed the same way.
+func ApplyDailyRules(cfg *config.Config) {
+	cfg.StrictMode = false
+	cfg.WordMode = false
+	cfg.Extra = false
+	cfg.StopOnError = "off"
+	cfg.Correction = "free"
+	cfg.Confidence = false
+	cfg.Rounds = 1
+	cfg.WarmUp = false
+}
@@ -0,0 +1,51 @@
+package source
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dgf/tygo/internal/test"
+)
+
+// Lines keeps the line breaks of a text, long lines wrap at the width.
+// The lines are typed as words, indentation and repeated spaces are dropped.
+type Lines struct {
+	Lines []string
+	Width int
+}
+
+// LoadLines reads the lines of a plain text file, a file without words is refused.
+func LoadLines(name string, width int) (Lines, error) {
+	data, err := os.ReadFile(filepath.Clean(name))
+	if err != nil {
+		return Lines{Lines: nil, Width: width}, fmt.Errorf("read lines failed: %w", err)
+	}
+
+	if len(strings.Fields(string(data))) == 0 {
+		return Lines{Lines: nil, Width: width}, &EmptyTextError{Name: name}
+	}
+
+	return Lines{Lines: strings.Split(string(data), "\n"), Width: width}, nil
+}
+
+// Text lays out the lines the same for every seed, blank lines are skipped.
+func (l Lines) Text(int64) test.Text {
+	grid := test.Grid{}
+
+	for _, line := range l.Lines {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			continue
+		}
+
+		if len(grid) > 0 {
+			grid[len(grid)-1] = append(grid[len(grid)-1], test.Enqueue(' '))
+		}
+
+		grid = append(grid, test.ToGrid(l.Width, words)...)
+	}
+
+	return test.Text{Words: nil, Grid: grid}
+}
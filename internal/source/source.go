@@ -0,0 +1,26 @@
+// Package source produces the texts of the typing tests.
+package source
+
+import "fmt"
+
+// Source names.
+const (
+	WordsSource = "words"
+	LinesSource = "lines"
+)
+
+type UnknownSourceError struct {
+	Name string
+}
+
+func (e *UnknownSourceError) Error() string {
+	return fmt.Sprintf("unknown text source %q", e.Name)
+}
+
+type EmptyTextError struct {
+	Name string
+}
+
+func (e *EmptyTextError) Error() string {
+	return fmt.Sprintf("no words in text file %q", e.Name)
+}
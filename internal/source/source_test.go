@@ -0,0 +1,116 @@
+package source_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/dgf/tygo/internal/config"
+	"github.com/dgf/tygo/internal/gen"
+	"github.com/dgf/tygo/internal/source"
+	"github.com/dgf/tygo/internal/test"
+)
+
+func TestWords_Text(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Default()
+	cfg.Punctuation = false // joins words
+	cfg.Language = "english"
+	generator := gen.Weighted{Words: []string{"foo", "bar", "baz", "qux"}, NoRepeat: 0}
+
+	words, err := source.NewWords(cfg, generator, gen.PreserveCase, nil)
+	if err != nil {
+		t.Fatalf("expected words, got: %v", err)
+	}
+
+	first := words.Text(42)
+	second := words.Text(42)
+
+	if len(first.Words) != cfg.WordCount || first.Grid != nil {
+		t.Errorf("expected %d words without grid, got: %q", cfg.WordCount, first.Words)
+	}
+
+	if !slices.Equal(first.Words, second.Words) {
+		t.Errorf("expected same words of the same seed, got: %q and %q", first.Words, second.Words)
+	}
+}
+
+func TestNewWords_UnknownLanguage(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Default()
+	cfg.Language = "klingon"
+
+	_, err := source.NewWords(cfg, gen.Weighted{Words: []string{"foo"}, NoRepeat: 0}, gen.PreserveCase, nil)
+
+	var unknown *gen.UnknownLanguageError
+	if !errors.As(err, &unknown) || unknown.Name != "klingon" {
+		t.Errorf("expected unknown language error, got: %v", err)
+	}
+}
+
+func TestLines_Text(t *testing.T) {
+	t.Parallel()
+
+	lines := source.Lines{Lines: []string{"func main() {", "", "\tfmt.Println(1,  2)", "}"}, Width: 10}
+	grid := lines.Text(1).ToGrid(100)
+	expected := []string{"func ", "main() { ", "fmt.Println(1, ", "2) ", "}"}
+
+	if len(grid) != len(expected) {
+		t.Fatalf("expected %d rows, got: %d", len(expected), len(grid))
+	}
+
+	for i, row := range grid {
+		if actual := runes(row); actual != expected[i] {
+			t.Errorf("expected row %d %q, got: %q", i, expected[i], actual)
+		}
+	}
+}
+
+func TestLoadLines(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for _, testCase := range []struct {
+		name    string
+		content string
+		lines   int
+	}{
+		{"empty", "", 0},
+		{"blank", " \n\t\n\n", 0},
+		{"text", "one\n\n  two\n", 4},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			name := filepath.Join(dir, testCase.name)
+			if err := os.WriteFile(name, []byte(testCase.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			lines, err := source.LoadLines(name, 10)
+
+			var empty *source.EmptyTextError
+			if testCase.lines == 0 && !errors.As(err, &empty) {
+				t.Errorf("expected empty text error, got: %v", err)
+			}
+
+			if len(lines.Lines) != testCase.lines {
+				t.Errorf("expected %d lines, got: %q", testCase.lines, lines.Lines)
+			}
+		})
+	}
+}
+
+func runes(cells []*test.Cell) string {
+	r := make([]rune, len(cells))
+	for i, c := range cells {
+		r[i] = c.Rune
+	}
+
+	return string(r)
+}
@@ -0,0 +1,104 @@
+package source
+
+import (
+	"fmt"
+
+	"github.com/dgf/tygo/internal/config"
+	"github.com/dgf/tygo/internal/gen"
+	"github.com/dgf/tygo/internal/test"
+)
+
+// Words generates a word list with the numbers, punctuation or symbols of the config.
+type Words struct {
+	Config    config.Config
+	Generator gen.Generator
+	Case      gen.LetterCase
+	Lower     map[string]string // lower forms of capitalized words, see gen.LowerForms
+	Language  gen.Language
+}
+
+// NewWords resolves the punctuation language of the config, an unknown one is refused.
+func NewWords(
+	cfg config.Config, generator gen.Generator, letterCase gen.LetterCase, lower map[string]string,
+) (Words, error) {
+	words := Words{Config: cfg, Generator: generator, Case: letterCase, Lower: lower, Language: gen.Language{}}
+
+	lang, err := gen.LoadLanguage(cfg.Language)
+	if err != nil {
+		return words, fmt.Errorf("words failed: %w", err)
+	}
+
+	words.Language = lang
+
+	return words, nil
+}
+
+func (w Words) Text(seed int64) test.Text {
+	cfg := w.Config
+	rnd := gen.NewRand(seed)
+	list := w.Generator.Generate(rnd, cfg.WordCount)
+
+	lang := w.Language
+
+	if cfg.Numbers {
+		list = gen.WithNumbers(rnd, cfg.Distribution.Number, list, map[gen.NumberFormat]int{
+			gen.Integer:  cfg.NumberFormat.Integer,
+			gen.Decimal:  cfg.NumberFormat.Decimal,
+			gen.Percent:  cfg.NumberFormat.Percent,
+			gen.Date:     cfg.NumberFormat.Date,
+			gen.Time:     cfg.NumberFormat.Time,
+			gen.Currency: cfg.NumberFormat.Currency,
+			gen.Phone:    cfg.NumberFormat.Phone,
+			gen.SemVer:   cfg.NumberFormat.Version,
+			gen.Range:    cfg.NumberFormat.Range,
+		}, lang.Locale)
+	}
+
+	punctuate := gen.PunctuationMarks
+	if cfg.Sentences {
+		punctuate = gen.Sentences
+	}
+
+	switch {
+	case cfg.Symbols:
+		list = gen.WithCase(rnd, list, w.Case, cfg.CaseWeight, w.Lower) // before, to keep e.g. camelCase
+		list = gen.Symbols(rnd, list, map[gen.Punctuation]int{
+			gen.Word:       cfg.SymbolFreqs.Word,
+			gen.SnakeCase:  cfg.SymbolFreqs.SnakeCase,
+			gen.CamelCase:  cfg.SymbolFreqs.CamelCase,
+			gen.Call:       cfg.SymbolFreqs.Call,
+			gen.Arrow:      cfg.SymbolFreqs.Arrow,
+			gen.NotEqual:   cfg.SymbolFreqs.NotEqual,
+			gen.LogicalAnd: cfg.SymbolFreqs.LogicalAnd,
+			gen.LogicalOr:  cfg.SymbolFreqs.LogicalOr,
+			gen.Include:    cfg.SymbolFreqs.Include,
+			gen.Variable:   cfg.SymbolFreqs.Variable,
+			gen.Tag:        cfg.SymbolFreqs.Tag,
+			gen.Index:      cfg.SymbolFreqs.Index,
+			gen.Assign:     cfg.SymbolFreqs.Assign,
+		})
+	case cfg.Punctuation:
+		list = punctuate(rnd, list, map[gen.Punctuation]int{
+			gen.Word:        cfg.Distribution.Word,
+			gen.Period:      cfg.Distribution.Period,
+			gen.Comma:       cfg.Distribution.Comma,
+			gen.Quotation:   cfg.Distribution.Quotation,
+			gen.Question:    cfg.Distribution.Question,
+			gen.Exclamation: cfg.Distribution.Exclamation,
+			gen.Brackets:    cfg.Distribution.Brackets,
+			gen.Braces:      cfg.Distribution.Braces,
+			gen.Parenthesis: cfg.Distribution.Parenthesis,
+			gen.Colon:       cfg.Distribution.Colon,
+			gen.Semicolon:   cfg.Distribution.Semicolon,
+			gen.Apostrophe:  cfg.Distribution.Apostrophe,
+			gen.Dash:        cfg.Distribution.Dash,
+			gen.Hyphen:      cfg.Distribution.Hyphen,
+			gen.Ellipsis:    cfg.Distribution.Ellipsis,
+		}, lang)
+		list = gen.WithCase(rnd, list, w.Case, cfg.CaseWeight, w.Lower)
+	default:
+		list = gen.WithCase(rnd, list, w.Case, cfg.CaseWeight, w.Lower)
+	}
+
+	return test.Text{Words: list, Grid: nil}
+}
@@ -6,6 +6,7 @@ type Cell struct {
 	Inputs []rune
 	Rune   rune
 	Status Status
+	Extra  bool // typed past the end of a word
 }
 
 type Cells []*Cell
@@ -15,5 +16,9 @@ func (c Cell) String() string {
 }
 
 func Enqueue(r rune) *Cell {
-	return &Cell{Rune: r, Status: Queued, Inputs: []rune{}}
+	return &Cell{Rune: r, Status: Queued, Inputs: []rune{}, Extra: false}
+}
+
+func Extra(r rune) *Cell {
+	return &Cell{Rune: r, Status: Failed, Inputs: []rune{r}, Extra: true}
 }
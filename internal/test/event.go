@@ -10,4 +10,7 @@ const (
 	EventNext
 	EventQuit
 	EventReset
+	EventPause
+	EventFocusIn
+	EventFocusOut
 )
@@ -9,7 +9,7 @@ func ToLines(cols int, words []string) []Line {
 
 	for _, word := range words {
 		runes := []rune(word)
-		if cols < lc+len(runes) {
+		if lc > 0 && cols < lc+len(runes) {
 			lines = append(lines, line)
 			line = Line{}
 			lc = 0
@@ -21,6 +21,11 @@ func TestToLines(t *testing.T) {
 			[]string{},
 			[]test.Line{},
 		},
+		{
+			"longer", 2,
+			[]string{"one"},
+			[]test.Line{{{'o', 'n', 'e'}}},
+		},
 		{
 			"one", 3,
 			[]string{"one"},
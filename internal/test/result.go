@@ -8,21 +8,81 @@ import (
 
 const AverageWordLength = 5
 
+// Mode tags a result, results of different modes aren't comparable.
+type Mode string
+
+const (
+	ModeNormal     Mode = "normal"
+	ModeConfidence Mode = "confidence"
+)
+
 type Result struct {
-	Duration               time.Duration
-	WordsPerMinute         int // WPM = (total keys pressed / 5) / duration in minutes
-	AccuracyPercent        int // AP = (correct keys pressed / total keys pressed) * 100
-	AdjustedWordsPerMinute int // AWPM = WPM * AP
+	Mode                   Mode          `json:"mode"`
+	Duration               time.Duration `json:"duration"`
+	WordsPerMinute         int           `json:"wpm"`            // WPM = (total keys pressed / 5) / duration in minutes
+	AccuracyPercent        int           `json:"accuracy"`       // AP = correct keys / (total keys + missed keys) * 100
+	AdjustedWordsPerMinute int           `json:"awpm"`           // AWPM = WPM * AP
+	Words                  int           `json:"words"`          // typed or skipped words
+	ErrorWords             int           `json:"errorWords"`     // words with at least one failed, missed or extra rune
+	Idle                   time.Duration `json:"idle"`           // excluded idle time, flags an interrupted test
+	Seed                   int64         `json:"seed"`           // of the generated text, reproduces it
+	Code                   string        `json:"code,omitempty"` // shares the text and its settings
 }
 
 func (r Result) String() string {
-	return fmt.Sprintf("%s\r\nWPM %4d\r\nACC  %3d%%\r\nAWPM %3d",
-		r.Duration, r.WordsPerMinute, r.AccuracyPercent, r.AdjustedWordsPerMinute)
+	header := r.Duration.String()
+	if r.Mode != ModeNormal {
+		header += fmt.Sprintf(" (%s)", r.Mode)
+	}
+
+	if r.Idle > 0 {
+		header += fmt.Sprintf(" idle %s excluded", r.Idle.Round(time.Second))
+	}
+
+	text := fmt.Sprintf("%s\r\nWPM %4d\r\nACC  %3d%%\r\nAWPM %3d\r\nERR  %3d/%d words\r\nSEED %d",
+		header, r.WordsPerMinute, r.AccuracyPercent, r.AdjustedWordsPerMinute, r.ErrorWords, r.Words, r.Seed)
+
+	if len(r.Code) > 0 {
+		text += "\r\nCODE " + r.Code
+	}
+
+	return text
+}
+
+type wordCount struct {
+	words      int
+	errorWords int
+	typed      bool
+	failed     bool
+}
+
+func (w *wordCount) add(c *Cell) {
+	w.typed = w.typed || len(c.Inputs) > 0 || c.Status == Missed
+	w.failed = w.failed || c.Extra || c.Status == Failed || c.Status == Missed
+
+	if c.Rune == ' ' && !c.Extra {
+		w.end()
+	}
+}
+
+func (w *wordCount) end() {
+	if w.typed {
+		w.words++
+
+		if w.failed {
+			w.errorWords++
+		}
+	}
+
+	w.typed = false
+	w.failed = false
 }
 
 func Calc(duration time.Duration, grid Grid) Result {
 	totalKeysPressed := 0
 	correctKeysPressed := 0
+	missedKeys := 0
+	count := wordCount{words: 0, errorWords: 0, typed: false, failed: false}
 
 	for _, row := range grid {
 		for _, cell := range row {
@@ -32,21 +92,35 @@ func Calc(duration time.Duration, grid Grid) Result {
 
 			totalKeysPressed += len(cell.Inputs)
 
+			if cell.Status == Missed {
+				missedKeys++
+			}
+
+			count.add(cell)
+
 			for _, i := range cell.Inputs {
-				if i == cell.Rune {
+				if i == cell.Rune && !cell.Extra {
 					correctKeysPressed++
 				}
 			}
 		}
 	}
 
+	count.end()
+
 	wpm := float64(totalKeysPressed/AverageWordLength) / duration.Minutes()
-	accuracy := float64(correctKeysPressed) / float64(totalKeysPressed)
+	accuracy := float64(correctKeysPressed) / float64(totalKeysPressed+missedKeys)
 
 	return Result{
+		Mode:                   ModeNormal,
 		Duration:               duration,
 		WordsPerMinute:         int(wpm),
 		AccuracyPercent:        int(100 * accuracy),
 		AdjustedWordsPerMinute: int(wpm * accuracy),
+		Words:                  count.words,
+		ErrorWords:             count.errorWords,
+		Idle:                   0,
+		Seed:                   0,
+		Code:                   "",
 	}
 }
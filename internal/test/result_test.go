@@ -0,0 +1,87 @@
+package test_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgf/tygo/internal/test"
+)
+
+func typeGrid(grid test.Grid, statuses string) {
+	i := 0
+
+	for _, row := range grid {
+		for _, cell := range row {
+			if i >= len(statuses) {
+				return
+			}
+
+			switch statuses[i] {
+			case 'p':
+				cell.Inputs = append(cell.Inputs, cell.Rune)
+				cell.Status = test.Passed
+			case 'f':
+				cell.Inputs = append(cell.Inputs, '#')
+				cell.Status = test.Failed
+			case 'm':
+				cell.Status = test.Missed
+			}
+
+			i++
+		}
+	}
+}
+
+func TestCalc_WordErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name       string
+		statuses   string
+		words      int
+		errorWords int
+		accuracy   int
+	}{
+		{"all passed", "ppppppppppp", 3, 0, 100},
+		{"one failed", "pfppppppppp", 3, 1, 90},
+		{"failed space", "pppfppppppp", 3, 1, 90},
+		{"missed rest", "pmmpppppppp", 3, 1, 81},
+		{"partial", "ppppp", 2, 0, 100},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			grid := test.ToGrid(20, []string{"one", "two", "foo"})
+			typeGrid(grid, testCase.statuses)
+
+			result := test.Calc(time.Minute, grid)
+
+			if result.Words != testCase.words || result.ErrorWords != testCase.errorWords {
+				t.Errorf("want %d/%d error words, got: %d/%d",
+					testCase.errorWords, testCase.words, result.ErrorWords, result.Words)
+			}
+
+			if result.AccuracyPercent != testCase.accuracy {
+				t.Errorf("want accuracy %d, got: %d", testCase.accuracy, result.AccuracyPercent)
+			}
+		})
+	}
+}
+
+func TestCalc_ExtraIsNoHit(t *testing.T) {
+	t.Parallel()
+
+	grid := test.ToGrid(20, []string{"ab", "cd"})
+	typeGrid(grid, "ppp")
+	grid[0] = append(grid[0][:2], append(test.Cells{test.Extra('x')}, grid[0][2:]...)...)
+
+	result := test.Calc(time.Minute, grid)
+
+	if result.AccuracyPercent != 75 {
+		t.Errorf("want accuracy 75, got: %d", result.AccuracyPercent)
+	}
+
+	if result.ErrorWords != 1 {
+		t.Errorf("want one error word, got: %d", result.ErrorWords)
+	}
+}
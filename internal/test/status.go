@@ -7,4 +7,5 @@ const (
 	Failed
 	Passed
 	Active
+	Missed
 )
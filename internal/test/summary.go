@@ -0,0 +1,63 @@
+package test
+
+import "time"
+
+// Summary aggregates the results of consecutive rounds.
+type Summary struct {
+	Rounds []Result
+	WarmUp bool // first round is excluded from the aggregates
+	Mean   Result
+	Best   Result
+	Worst  Result
+}
+
+// Counted returns the rounds included in the aggregates.
+func (s Summary) Counted() []Result {
+	if s.WarmUp && len(s.Rounds) > 1 {
+		return s.Rounds[1:]
+	}
+
+	return s.Rounds
+}
+
+// Summarize aggregates the rounds, ranked by adjusted words per minute.
+func Summarize(rounds []Result, warmUp bool) Summary {
+	s := Summary{Rounds: rounds, WarmUp: warmUp, Mean: Result{}, Best: Result{}, Worst: Result{}}
+
+	counted := s.Counted()
+	if len(counted) == 0 {
+		return s
+	}
+
+	s.Best, s.Worst = counted[0], counted[0]
+	s.Mean.Mode = counted[0].Mode
+
+	for _, r := range counted {
+		if r.AdjustedWordsPerMinute > s.Best.AdjustedWordsPerMinute {
+			s.Best = r
+		}
+
+		if r.AdjustedWordsPerMinute < s.Worst.AdjustedWordsPerMinute {
+			s.Worst = r
+		}
+
+		s.Mean.Duration += r.Duration
+		s.Mean.WordsPerMinute += r.WordsPerMinute
+		s.Mean.AccuracyPercent += r.AccuracyPercent
+		s.Mean.AdjustedWordsPerMinute += r.AdjustedWordsPerMinute
+		s.Mean.Words += r.Words
+		s.Mean.ErrorWords += r.ErrorWords
+		s.Mean.Idle += r.Idle
+	}
+
+	n := len(counted)
+	s.Mean.Duration /= time.Duration(n)
+	s.Mean.WordsPerMinute /= n
+	s.Mean.AccuracyPercent /= n
+	s.Mean.AdjustedWordsPerMinute /= n
+	s.Mean.Words /= n
+	s.Mean.ErrorWords /= n
+	s.Mean.Idle /= time.Duration(n)
+
+	return s
+}
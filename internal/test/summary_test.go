@@ -0,0 +1,49 @@
+package test_test
+
+import (
+	"testing"
+
+	"github.com/dgf/tygo/internal/test"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	rounds := []test.Result{
+		{AdjustedWordsPerMinute: 20, WordsPerMinute: 30, AccuracyPercent: 70},
+		{AdjustedWordsPerMinute: 50, WordsPerMinute: 55, AccuracyPercent: 90},
+		{AdjustedWordsPerMinute: 40, WordsPerMinute: 45, AccuracyPercent: 88},
+	}
+
+	for _, testCase := range []struct {
+		name   string
+		rounds []test.Result
+		warmUp bool
+		mean   int
+		best   int
+		worst  int
+	}{
+		{"all rounds", rounds, false, 36, 50, 20},
+		{"warm-up", rounds, true, 45, 50, 40},
+		{"single warm-up round", rounds[:1], true, 20, 20, 20},
+		{"no rounds", nil, false, 0, 0, 0},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := test.Summarize(testCase.rounds, testCase.warmUp)
+
+			if s.Mean.AdjustedWordsPerMinute != testCase.mean {
+				t.Errorf("expected mean %d, got: %d", testCase.mean, s.Mean.AdjustedWordsPerMinute)
+			}
+
+			if s.Best.AdjustedWordsPerMinute != testCase.best {
+				t.Errorf("expected best %d, got: %d", testCase.best, s.Best.AdjustedWordsPerMinute)
+			}
+
+			if s.Worst.AdjustedWordsPerMinute != testCase.worst {
+				t.Errorf("expected worst %d, got: %d", testCase.worst, s.Worst.AdjustedWordsPerMinute)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package test
+
+// Text is the content of a test, either words to lay out or a grid laid out by its source.
+type Text struct {
+	Words []string
+	Grid  Grid
+}
+
+// ToGrid wraps the words at the columns, a laid out grid is returned as is.
+func (t Text) ToGrid(cols int) Grid {
+	if t.Grid != nil {
+		return t.Grid
+	}
+
+	return ToGrid(cols, t.Words)
+}
@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"os"
 	"runtime/debug"
+	"time"
 
 	"github.com/dgf/tygo/internal/config"
 	"github.com/dgf/tygo/internal/dict"
 	"github.com/dgf/tygo/internal/display"
 	"github.com/dgf/tygo/internal/game"
+	"github.com/dgf/tygo/internal/gen"
+	"github.com/dgf/tygo/internal/history"
 	"github.com/dgf/tygo/internal/input"
+	"github.com/dgf/tygo/internal/share"
+	"github.com/dgf/tygo/internal/source"
 	"golang.org/x/term"
 )
 
@@ -65,6 +70,328 @@ func MustLoadWords(cfg config.Config, file string) []string {
 	return words
 }
 
+// MustResolveLanguage defaults the punctuation language to the one of the dictionary.
+func MustResolveLanguage(cfg *config.Config) {
+	if len(cfg.Language) == 0 {
+		cfg.Language = Dictionary(cfg.Dictionary).Language()
+	}
+
+	_, err := gen.LoadLanguage(cfg.Language)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid language: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+}
+
+// MustLoadSource returns the configured source of the test texts.
+func MustLoadSource(cfg config.Config, file string) game.TextSource {
+	switch cfg.Source {
+	case source.WordsSource:
+		generator, ranked := MustLoadGenerator(cfg, file)
+
+		words, err := source.NewWords(cfg, generator, MustParseCase(cfg), gen.LowerForms(ranked))
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Invalid language: %v\n", err)
+
+			os.Exit(ExitUserError)
+		}
+
+		return words
+	case source.LinesSource:
+		if len(file) == 0 {
+			_, _ = fmt.Fprintln(os.Stderr, "The lines source requires a plain text -file")
+
+			os.Exit(ExitUserError)
+		}
+
+		lines, err := source.LoadLines(file, cfg.Width-1)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Lines load failed: %v\n", err)
+
+			os.Exit(ExitUserError)
+		}
+
+		return lines
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid text source: %v\n", &source.UnknownSourceError{Name: cfg.Source})
+
+		os.Exit(ExitUserError)
+
+		return nil
+	}
+}
+
+// MustLoadGenerator returns the configured generator with its words or trained corpus, and the ranked words if any.
+func MustLoadGenerator(cfg config.Config, file string) (gen.Generator, []string) {
+	switch cfg.Generator {
+	case gen.WeightedGenerator:
+		words := MustLoadWords(cfg, file)
+
+		return gen.Weighted{Words: words, NoRepeat: cfg.NoRepeat}, words
+	case gen.MarkovGenerator:
+		if len(file) > 0 {
+			_, _ = fmt.Fprintln(os.Stderr, "The Markov generator is trained on a corpus, use -corpus instead of -file")
+
+			os.Exit(ExitUserError)
+		}
+
+		markov, err := gen.NewMarkov(gen.MarkovOrder, MustLoadCorpus(cfg))
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Invalid corpus: %v\n", err)
+
+			os.Exit(ExitUserError)
+		}
+
+		return markov, nil // capitals of the corpus are kept
+	case gen.PseudoGenerator:
+		words := MustLoadWords(cfg, file)
+
+		return gen.NewPseudo(words, cfg.Charset), words
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid generator: %v\n", &gen.UnknownGeneratorError{Name: cfg.Generator})
+
+		os.Exit(ExitUserError)
+
+		return nil, nil
+	}
+}
+
+func MustLoadCorpus(cfg config.Config) string {
+	var (
+		text string
+		err  error
+	)
+
+	if len(cfg.Corpus) > 0 {
+		text, err = dict.LoadCorpusFile(cfg.Corpus)
+	} else {
+		text, err = dict.LoadCorpus(Dictionary(cfg.Dictionary).Language())
+	}
+
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Corpus load failed: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	return text
+}
+
+func LoadTheme(cfg config.Config) (display.Theme, error) {
+	spec, ok := cfg.Themes[cfg.Theme]
+	if !ok {
+		theme, err := display.LoadTheme(cfg.Theme)
+		if err != nil {
+			return nil, fmt.Errorf("built-in theme: %w", err)
+		}
+
+		return theme, nil
+	}
+
+	theme := display.Theme{}
+
+	for name, s := range spec {
+		status, err := display.ParseStatus(name)
+		if err != nil {
+			return nil, fmt.Errorf("theme %q: %w", cfg.Theme, err)
+		}
+
+		theme[status], err = display.ParseStyle(s.Fg, s.Bg, s.Attrs)
+		if err != nil {
+			return nil, fmt.Errorf("theme %q, %s style: %w", cfg.Theme, name, err)
+		}
+	}
+
+	return theme, nil
+}
+
+func MustLoadPalette(cfg config.Config) display.Palette {
+	errDisplay, err := display.ParseErrorDisplay(cfg.Errors)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid error display: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	profile := display.DetectProfile(os.Getenv)
+	if profile == display.Monochrome {
+		return display.MonoTheme().Palette(profile).ShowErrors(errDisplay)
+	}
+
+	theme, err := LoadTheme(cfg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Theme load failed: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	return theme.Palette(profile).ShowErrors(errDisplay)
+}
+
+func MustParseCaret(cfg config.Config) display.Caret {
+	caret, err := display.ParseCaret(cfg.Caret, cfg.CaretBlink)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid caret: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	return caret
+}
+
+func MustParseCase(cfg config.Config) gen.LetterCase {
+	letterCase, err := gen.ParseCase(cfg.Case)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid case: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	return letterCase
+}
+
+// ViewportLines limits the visible grid rows to fit the terminal together with the result,
+// or the summary of a set with more rounds.
+func ViewportLines(out *os.File, lines, rounds int) int {
+	_, height, err := term.GetSize(int(out.Fd()))
+	if err != nil || height == 0 {
+		return lines
+	}
+
+	reserved := display.ResultLines
+	if rounds > 1 {
+		reserved = max(reserved, display.SummaryLines(rounds))
+	}
+
+	maxLines := max(1, height-reserved)
+	if lines < 1 || lines > maxLines {
+		return maxLines
+	}
+
+	return lines
+}
+
+func MustParseRules(cfg config.Config) game.Rules {
+	rules, err := game.ParseRules(cfg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid rules: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	return rules
+}
+
+// Recorder saves finished tests to the user history file, if enabled and accessible.
+func Recorder(cfg config.Config) game.Recorder {
+	if !cfg.History {
+		return nil
+	}
+
+	file, err := history.UserFile()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "History disabled: %v\n", err)
+
+		return nil
+	}
+
+	return file
+}
+
+// MustApplyCode overrides the text settings with the ones of a shared code.
+func MustApplyCode(cfg *config.Config, code, file string) {
+	if len(code) == 0 {
+		return
+	}
+
+	if len(file) > 0 {
+		_, _ = fmt.Fprintln(os.Stderr, "A code can't be combined with a vocabulary file")
+
+		os.Exit(ExitUserError)
+	}
+
+	c, err := share.Parse(code)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Invalid code: %v\n", err)
+
+		os.Exit(ExitUserError)
+	}
+
+	c.Apply(cfg)
+}
+
+// MustStartDaily applies the challenge of the UTC date, the recorder marks repeated attempts as practice.
+// The banner shows the code of the challenge, so builds of another generator version are visible.
+// Without history, attempts aren't tracked and a notice is printed.
+func MustStartDaily(cfg *config.Config, file string, date time.Time) game.Recorder {
+	if len(file) > 0 {
+		_, _ = fmt.Fprintln(os.Stderr, "The daily challenge can't be combined with a vocabulary file")
+
+		os.Exit(ExitUserError)
+	}
+
+	date = date.UTC()
+	code := share.Daily(date)
+	code.Apply(cfg)
+	share.ApplyDailyRules(cfg)
+
+	banner := fmt.Sprintf("Daily challenge %s, code %s", date.Format(time.DateOnly), code)
+
+	if !cfg.History {
+		_, _ = fmt.Fprintln(os.Stderr, "History is disabled, the daily attempts aren't tracked, enable it with -history")
+		_, _ = fmt.Fprintln(os.Stdout, banner)
+
+		return nil
+	}
+
+	daily, err := LoadDaily(date)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "History load failed, the daily attempts aren't tracked: %v\n", err)
+		_, _ = fmt.Fprintln(os.Stdout, banner)
+
+		return nil
+	}
+
+	_, _ = fmt.Fprint(os.Stdout, banner)
+
+	if daily.Practice {
+		_, _ = fmt.Fprint(os.Stdout, " (practice, already attempted)")
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	return daily
+}
+
+func LoadDaily(date time.Time) (*history.Daily, error) {
+	file, err := history.UserFile()
+	if err != nil {
+		return nil, fmt.Errorf("no history file: %w", err)
+	}
+
+	daily, err := file.Daily(date)
+	if err != nil {
+		return nil, fmt.Errorf("check daily attempts failed: %w", err)
+	}
+
+	return &daily, nil
+}
+
+// Sharer creates codes of the generated texts, texts of a file or corpus aren't shareable.
+func Sharer(cfg config.Config, file string) game.Sharer {
+	if len(file) > 0 || len(cfg.Corpus) > 0 || cfg.Source != source.WordsSource {
+		return nil
+	}
+
+	return share.FromConfig(cfg)
+}
+
+func Usage() {
+	_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [run <code> | daily] [flags]\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
 func MustMakeRaw(in *os.File) *term.State {
 	fd := int(in.Fd())
 
@@ -84,11 +411,14 @@ func MustMakeRaw(in *os.File) *term.State {
 	return state
 }
 
-func RestoreTerm(in *os.File, oldState *term.State) {
+func RestoreTerm(in, out *os.File, oldState *term.State) {
 	fd := int(in.Fd())
 
 	_ = term.Restore(fd, oldState)
 
+	display.RestoreCursor(out)
+	display.ReportFocus(out, false)
+
 	if r := recover(); r != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "%v - %s", r, debug.Stack())
 
@@ -98,29 +428,89 @@ func RestoreTerm(in *os.File, oldState *term.State) {
 
 func main() {
 	cfg := MustLoadConfig()
+	args := os.Args[1:]
+
+	var file, code string
 
-	var file string
+	daily := false
+
+	switch {
+	case len(args) > 1 && args[0] == "run":
+		code, args = args[1], args[2:]
+	case len(args) > 0 && args[0] == "daily":
+		daily, args = true, args[1:]
+	}
 
 	flag.StringVar(&cfg.Dictionary, "dict", cfg.Dictionary, "dictionary to use, available: german, english")
+	flag.StringVar(&cfg.Language, "lang", cfg.Language,
+		"punctuation language, available: english, german, french, spanish, empty for the dictionary's")
 
 	flag.IntVar(&cfg.TopWords, "top", cfg.TopWords, "top count of words to load from source (dict or file)")
 	flag.IntVar(&cfg.WordCount, "count", cfg.WordCount, "number of words to include in the typing test")
 	flag.IntVar(&cfg.Width, "width", cfg.Width, "display width for the typing text")
+	flag.IntVar(&cfg.Lines, "lines", cfg.Lines, "visible lines of the typing text, 0 to fit the terminal")
 
 	flag.BoolVar(&cfg.Numbers, "nums", cfg.Numbers, "enable number mode")
 	flag.BoolVar(&cfg.Punctuation, "punct", cfg.Punctuation, "enable punctuation marks")
+	flag.BoolVar(&cfg.Symbols, "syms", cfg.Symbols, "enable programming symbols instead of punctuation marks")
+	flag.BoolVar(&cfg.Sentences, "sentences", cfg.Sentences, "structure punctuation marks as sentences and clauses")
+	flag.StringVar(&cfg.Case, "case", cfg.Case, "letter case, available: preserve, lower, sentence, title, random")
+	flag.IntVar(&cfg.CaseWeight, "caseweight", cfg.CaseWeight, "percentage of capitalized words in random case")
 	flag.BoolVar(&cfg.StrictMode, "strict", cfg.StrictMode, "enable strict mode, restarts on every error")
+	flag.StringVar(&cfg.StopOnError, "stop", cfg.StopOnError, "stop on error mode, available: off, letter, word")
+	flag.IntVar(&cfg.IdleSeconds, "idle", cfg.IdleSeconds, "idle seconds, longer keystroke gaps are excluded, 0 disables")
+	flag.BoolVar(&cfg.AutoPause, "autopause", cfg.AutoPause, "pause on terminal focus loss, [CTRL+P] pauses anytime")
+	flag.BoolVar(&cfg.Confidence, "confidence", cfg.Confidence, "enable confidence mode, backspace is disabled")
+	flag.StringVar(&cfg.Correction, "correct", cfg.Correction, "correction policy, available: free, word, incorrect, none")
+	flag.BoolVar(&cfg.WordMode, "wordmode", cfg.WordMode, "enable word mode, space skips to the next word")
+	flag.IntVar(&cfg.Rounds, "rounds", cfg.Rounds, "number of consecutive tests summarized as one set")
+	flag.BoolVar(&cfg.WarmUp, "warmup", cfg.WarmUp, "exclude the first round of a set as warm-up")
+	flag.BoolVar(&cfg.History, "history", cfg.History, "save finished tests to the history file")
+	flag.BoolVar(&cfg.Extra, "extra", cfg.Extra, "insert characters typed past the end of a word")
+
+	flag.Int64Var(&cfg.Seed, "seed", cfg.Seed, "seed of the generated text, the same seed repeats the text, 0 for random")
+
+	flag.StringVar(&cfg.Source, "source", cfg.Source, "text source, available: words, lines (of a plain text -file)")
+	flag.StringVar(&cfg.Generator, "gen", cfg.Generator, "word generator, available: weighted, markov, pseudo")
+	flag.StringVar(&cfg.Corpus, "corpus", cfg.Corpus, "plain text file to train the markov generator, bundled if empty")
+	flag.StringVar(&cfg.Charset, "charset", cfg.Charset, "letters of the pseudo generator, e.g. asdfjkl")
+	flag.StringVar(&code, "code", code, "shared code that repeats a test, overrides the text settings")
+	flag.StringVar(&file, "file", "", "vocabulary JSON file with 'words' list, plain text for the lines source")
+
+	flag.StringVar(&cfg.Theme, "theme", cfg.Theme, "color theme, built-in: default, mono, solarized, contrast")
+	flag.StringVar(&cfg.Caret, "caret", cfg.Caret, "caret style, available: reverse, block, underline, bar")
+	flag.BoolVar(&cfg.CaretBlink, "blink", cfg.CaretBlink, "blinking caret (not for reverse style)")
+	flag.StringVar(&cfg.Errors, "errors", cfg.Errors,
+		"rune shown on errors, available: expected, typed, both (failed cells take two columns)")
+
+	flag.Usage = Usage
+	_ = flag.CommandLine.Parse(args)
+
+	recorder := Recorder(cfg)
+	if daily {
+		recorder = MustStartDaily(&cfg, file, time.Now().UTC())
+	} else {
+		MustApplyCode(&cfg, code, file)
+	}
 
-	flag.StringVar(&file, "file", "", "vocabulary JSON file with 'words' list")
-
-	flag.Parse()
+	MustResolveLanguage(&cfg)
 
 	in := os.Stdin
 	out := os.Stdout
-	words := MustLoadWords(cfg, file)
+	texts := MustLoadSource(cfg, file)
+	rules := MustParseRules(cfg)
+	opts := display.Options{
+		Palette: MustLoadPalette(cfg),
+		Caret:   MustParseCaret(cfg),
+		Lines:   ViewportLines(out, cfg.Lines, cfg.Rounds),
+	}
 	state := MustMakeRaw(in)
 
-	defer RestoreTerm(in, state)
+	defer RestoreTerm(in, out, state)
+
+	if cfg.AutoPause {
+		display.ReportFocus(out, true)
+	}
 
-	input.Loop(in, game.NewGame(cfg, words, display.NewRenderer(out)))
+	input.Loop(in, game.NewGame(cfg, rules, texts, display.NewRenderer(out, opts), recorder, Sharer(cfg, file)))
 }